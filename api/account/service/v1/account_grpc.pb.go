@@ -24,6 +24,8 @@ type AccountClient interface {
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountReply, error)
 	ListAccount(ctx context.Context, in *ListAccountRequest, opts ...grpc.CallOption) (*ListAccountReply, error)
 	ListWithIds(ctx context.Context, in *ListWithIdsRequest, opts ...grpc.CallOption) (*ListWithIdsReply, error)
+	// 按昵称批量查询账户, 昵称可能重复, 同一昵称可能返回多个账户
+	ListWithNicknames(ctx context.Context, in *ListWithNicknamesRequest, opts ...grpc.CallOption) (*ListWithNicknamesReply, error)
 	EmailLogin(ctx context.Context, in *EmailLoginRequest, opts ...grpc.CallOption) (*AccountLoginReply, error)
 }
 
@@ -89,6 +91,15 @@ func (c *accountClient) ListWithIds(ctx context.Context, in *ListWithIdsRequest,
 	return out, nil
 }
 
+func (c *accountClient) ListWithNicknames(ctx context.Context, in *ListWithNicknamesRequest, opts ...grpc.CallOption) (*ListWithNicknamesReply, error) {
+	out := new(ListWithNicknamesReply)
+	err := c.cc.Invoke(ctx, "/account.service.v1.Account/ListWithNicknames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountClient) EmailLogin(ctx context.Context, in *EmailLoginRequest, opts ...grpc.CallOption) (*AccountLoginReply, error) {
 	out := new(AccountLoginReply)
 	err := c.cc.Invoke(ctx, "/account.service.v1.Account/EmailLogin", in, out, opts...)
@@ -108,6 +119,8 @@ type AccountServer interface {
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountReply, error)
 	ListAccount(context.Context, *ListAccountRequest) (*ListAccountReply, error)
 	ListWithIds(context.Context, *ListWithIdsRequest) (*ListWithIdsReply, error)
+	// 按昵称批量查询账户, 昵称可能重复, 同一昵称可能返回多个账户
+	ListWithNicknames(context.Context, *ListWithNicknamesRequest) (*ListWithNicknamesReply, error)
 	EmailLogin(context.Context, *EmailLoginRequest) (*AccountLoginReply, error)
 	mustEmbedUnimplementedAccountServer()
 }
@@ -134,6 +147,9 @@ func (UnimplementedAccountServer) ListAccount(context.Context, *ListAccountReque
 func (UnimplementedAccountServer) ListWithIds(context.Context, *ListWithIdsRequest) (*ListWithIdsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWithIds not implemented")
 }
+func (UnimplementedAccountServer) ListWithNicknames(context.Context, *ListWithNicknamesRequest) (*ListWithNicknamesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWithNicknames not implemented")
+}
 func (UnimplementedAccountServer) EmailLogin(context.Context, *EmailLoginRequest) (*AccountLoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmailLogin not implemented")
 }
@@ -258,6 +274,24 @@ func _Account_ListWithIds_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Account_ListWithNicknames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWithNicknamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).ListWithNicknames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.service.v1.Account/ListWithNicknames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).ListWithNicknames(ctx, req.(*ListWithNicknamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Account_EmailLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmailLoginRequest)
 	if err := dec(in); err != nil {
@@ -307,6 +341,10 @@ var Account_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWithIds",
 			Handler:    _Account_ListWithIds_Handler,
 		},
+		{
+			MethodName: "ListWithNicknames",
+			Handler:    _Account_ListWithNicknames_Handler,
+		},
 		{
 			MethodName: "EmailLogin",
 			Handler:    _Account_EmailLogin_Handler,
@@ -22,6 +22,7 @@ type AccountHTTPServer interface {
 	EmailLogin(context.Context, *EmailLoginRequest) (*AccountLoginReply, error)
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountReply, error)
 	ListWithIds(context.Context, *ListWithIdsRequest) (*ListWithIdsReply, error)
+	ListWithNicknames(context.Context, *ListWithNicknamesRequest) (*ListWithNicknamesReply, error)
 	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountReply, error)
 }
 
@@ -31,6 +32,7 @@ func RegisterAccountHTTPServer(s *http.Server, srv AccountHTTPServer) {
 	r.PUT("/account", _Account_UpdateAccount0_HTTP_Handler(srv))
 	r.GET("/account/{id}", _Account_GetAccount0_HTTP_Handler(srv))
 	r.POST("/account/list/ids", _Account_ListWithIds0_HTTP_Handler(srv))
+	r.POST("/account/list/nicknames", _Account_ListWithNicknames0_HTTP_Handler(srv))
 	r.POST("/account/login", _Account_EmailLogin0_HTTP_Handler(srv))
 }
 
@@ -113,6 +115,25 @@ func _Account_ListWithIds0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Con
 	}
 }
 
+func _Account_ListWithNicknames0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWithNicknamesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/account.service.v1.Account/ListWithNicknames")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWithNicknames(ctx, req.(*ListWithNicknamesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWithNicknamesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Account_EmailLogin0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in EmailLoginRequest
@@ -137,6 +158,7 @@ type AccountHTTPClient interface {
 	EmailLogin(ctx context.Context, req *EmailLoginRequest, opts ...http.CallOption) (rsp *AccountLoginReply, err error)
 	GetAccount(ctx context.Context, req *GetAccountRequest, opts ...http.CallOption) (rsp *GetAccountReply, err error)
 	ListWithIds(ctx context.Context, req *ListWithIdsRequest, opts ...http.CallOption) (rsp *ListWithIdsReply, err error)
+	ListWithNicknames(ctx context.Context, req *ListWithNicknamesRequest, opts ...http.CallOption) (rsp *ListWithNicknamesReply, err error)
 	UpdateAccount(ctx context.Context, req *UpdateAccountRequest, opts ...http.CallOption) (rsp *UpdateAccountReply, err error)
 }
 
@@ -200,6 +222,19 @@ func (c *AccountHTTPClientImpl) ListWithIds(ctx context.Context, in *ListWithIds
 	return &out, err
 }
 
+func (c *AccountHTTPClientImpl) ListWithNicknames(ctx context.Context, in *ListWithNicknamesRequest, opts ...http.CallOption) (*ListWithNicknamesReply, error) {
+	var out ListWithNicknamesReply
+	pattern := "/account/list/nicknames"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/account.service.v1.Account/ListWithNicknames"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AccountHTTPClientImpl) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...http.CallOption) (*UpdateAccountReply, error) {
 	var out UpdateAccountReply
 	pattern := "/account"
@@ -175,8 +175,19 @@ type GetCommentSubjectReply struct {
 	Count     int32  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 	RootCount int32  `protobuf:"varint,6,opt,name=root_count,json=rootCount,proto3" json:"root_count,omitempty"`
 	AllCount  int32  `protobuf:"varint,7,opt,name=all_count,json=allCount,proto3" json:"all_count,omitempty"`
-	State     int32  `protobuf:"varint,8,opt,name=state,proto3" json:"state,omitempty"`
-	CreatedAt int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 评论区状态, 0 开放, 1 关闭, 2 锁定
+	State     int32 `protobuf:"varint,8,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 能否发表新评论, 为 false 时客户端隐藏评论输入框
+	Commentable bool `protobuf:"varint,10,opt,name=commentable,proto3" json:"commentable,omitempty"`
+	// 主题标题与规范链接, 未登记的主题为空
+	Title      string            `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
+	Url        string            `protobuf:"bytes,12,opt,name=url,proto3" json:"url,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,13,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 评论内容的最大字符数, 为0时不限制
+	MaxMessageLength int32 `protobuf:"varint,14,opt,name=max_message_length,json=maxMessageLength,proto3" json:"max_message_length,omitempty"`
+	// 评论的最大层级, 1 只允许根评论, 2 允许回复根评论但不能回复楼中楼, 为0时不限制
+	MaxReplyDepth int32 `protobuf:"varint,15,opt,name=max_reply_depth,json=maxReplyDepth,proto3" json:"max_reply_depth,omitempty"`
 }
 
 func (x *GetCommentSubjectReply) Reset() {
@@ -274,6 +285,48 @@ func (x *GetCommentSubjectReply) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *GetCommentSubjectReply) GetCommentable() bool {
+	if x != nil {
+		return x.Commentable
+	}
+	return false
+}
+
+func (x *GetCommentSubjectReply) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetCommentSubjectReply) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetCommentSubjectReply) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetCommentSubjectReply) GetMaxMessageLength() int32 {
+	if x != nil {
+		return x.MaxMessageLength
+	}
+	return 0
+}
+
+func (x *GetCommentSubjectReply) GetMaxReplyDepth() int32 {
+	if x != nil {
+		return x.MaxReplyDepth
+	}
+	return 0
+}
+
 type SaveCommentReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -415,6 +468,110 @@ func (x *GetCommentReply) GetComment() *CommentData {
 	return nil
 }
 
+type GetCommentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCommentStatusRequest) Reset() {
+	*x = GetCommentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentStatusRequest) ProtoMessage() {}
+
+func (x *GetCommentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCommentStatusRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCommentStatusReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0: 等待保存 1: 已保存 2: 审核未通过
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// 评论状态, 只在已保存时有效
+	State int32 `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *GetCommentStatusReply) Reset() {
+	*x = GetCommentStatusReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentStatusReply) ProtoMessage() {}
+
+func (x *GetCommentStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentStatusReply.ProtoReflect.Descriptor instead.
+func (*GetCommentStatusReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCommentStatusReply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetCommentStatusReply) GetState() int32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
 type GetCommentListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -422,15 +579,19 @@ type GetCommentListRequest struct {
 
 	ObjId   uint64 `protobuf:"varint,1,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
 	ObjType int32  `protobuf:"varint,2,opt,name=obj_type,json=objType,proto3" json:"obj_type,omitempty"`
-	Page    int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Size    int32  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Reply   int32  `protobuf:"varint,5,opt,name=reply,proto3" json:"reply,omitempty"`
+	// 每条评论预览的回复数, 不填时使用 obj_type 的默认值, 显式传 0 表示不预览回复
+	Reply  *int32 `protobuf:"varint,5,opt,name=reply,proto3,oneof" json:"reply,omitempty"`
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 0: 最新 1: 最热 2: 点赞最多, 不填时使用 obj_type 的默认排序
+	// 显式传 0 按最新排序, 旧版客户端不会发送 0 值, 会得到 obj_type 的默认排序
+	Sort *int32 `protobuf:"varint,7,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
 }
 
 func (x *GetCommentListRequest) Reset() {
 	*x = GetCommentListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[6]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +604,7 @@ func (x *GetCommentListRequest) String() string {
 func (*GetCommentListRequest) ProtoMessage() {}
 
 func (x *GetCommentListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[6]
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +617,7 @@ func (x *GetCommentListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentListRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentListRequest) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{6}
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetCommentListRequest) GetObjId() uint64 {
@@ -473,23 +634,30 @@ func (x *GetCommentListRequest) GetObjType() int32 {
 	return 0
 }
 
-func (x *GetCommentListRequest) GetPage() int32 {
+func (x *GetCommentListRequest) GetSize() int32 {
 	if x != nil {
-		return x.Page
+		return x.Size
 	}
 	return 0
 }
 
-func (x *GetCommentListRequest) GetSize() int32 {
-	if x != nil {
-		return x.Size
+func (x *GetCommentListRequest) GetReply() int32 {
+	if x != nil && x.Reply != nil {
+		return *x.Reply
 	}
 	return 0
 }
 
-func (x *GetCommentListRequest) GetReply() int32 {
+func (x *GetCommentListRequest) GetCursor() string {
 	if x != nil {
-		return x.Reply
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetCommentListRequest) GetSort() int32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
 	}
 	return 0
 }
@@ -499,13 +667,15 @@ type GetCommentListReply struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*CommentData `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Comments   []*CommentData `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor string         `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore    bool           `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
 func (x *GetCommentListReply) Reset() {
 	*x = GetCommentListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[7]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +688,7 @@ func (x *GetCommentListReply) String() string {
 func (*GetCommentListReply) ProtoMessage() {}
 
 func (x *GetCommentListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[7]
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +701,7 @@ func (x *GetCommentListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentListReply.ProtoReflect.Descriptor instead.
 func (*GetCommentListReply) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{7}
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetCommentListReply) GetComments() []*CommentData {
@@ -541,20 +711,34 @@ func (x *GetCommentListReply) GetComments() []*CommentData {
 	return nil
 }
 
+func (x *GetCommentListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCommentListReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 type GetReplyListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	RootId uint64 `protobuf:"varint,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
-	Page   int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Size   int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *GetReplyListRequest) Reset() {
 	*x = GetReplyListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[8]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -567,7 +751,7 @@ func (x *GetReplyListRequest) String() string {
 func (*GetReplyListRequest) ProtoMessage() {}
 
 func (x *GetReplyListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[8]
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +764,7 @@ func (x *GetReplyListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReplyListRequest.ProtoReflect.Descriptor instead.
 func (*GetReplyListRequest) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{8}
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetReplyListRequest) GetRootId() uint64 {
@@ -590,46 +774,46 @@ func (x *GetReplyListRequest) GetRootId() uint64 {
 	return 0
 }
 
-func (x *GetReplyListRequest) GetPage() int32 {
+func (x *GetReplyListRequest) GetSize() int32 {
 	if x != nil {
-		return x.Page
+		return x.Size
 	}
 	return 0
 }
 
-func (x *GetReplyListRequest) GetSize() int32 {
+func (x *GetReplyListRequest) GetCursor() string {
 	if x != nil {
-		return x.Size
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-type LikeCommentRequest struct {
+type ListMyCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Like int32  `protobuf:"varint,2,opt,name=like,proto3" json:"like,omitempty"`
+	Size   int32  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *LikeCommentRequest) Reset() {
-	*x = LikeCommentRequest{}
+func (x *ListMyCommentRequest) Reset() {
+	*x = ListMyCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[9]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LikeCommentRequest) String() string {
+func (x *ListMyCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentRequest) ProtoMessage() {}
+func (*ListMyCommentRequest) ProtoMessage() {}
 
-func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[9]
+func (x *ListMyCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,48 +824,52 @@ func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
-func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListMyCommentRequest.ProtoReflect.Descriptor instead.
+func (*ListMyCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LikeCommentRequest) GetId() uint64 {
+func (x *ListMyCommentRequest) GetSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.Size
 	}
 	return 0
 }
 
-func (x *LikeCommentRequest) GetLike() int32 {
+func (x *ListMyCommentRequest) GetCursor() string {
 	if x != nil {
-		return x.Like
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-type LikeCommentReply struct {
+type ReactCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 为空时取消表态
+	Reaction string `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
 }
 
-func (x *LikeCommentReply) Reset() {
-	*x = LikeCommentReply{}
+func (x *ReactCommentRequest) Reset() {
+	*x = ReactCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[10]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LikeCommentReply) String() string {
+func (x *ReactCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentReply) ProtoMessage() {}
+func (*ReactCommentRequest) ProtoMessage() {}
 
-func (x *LikeCommentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[10]
+func (x *ReactCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,60 +880,48 @@ func (x *LikeCommentReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentReply.ProtoReflect.Descriptor instead.
-func (*LikeCommentReply) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReactCommentRequest.ProtoReflect.Descriptor instead.
+func (*ReactCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{12}
 }
 
-type CommentData struct {
+func (x *ReactCommentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReactCommentRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type ReactCommentReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id             uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MemberId       uint64         `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	Nickname       string         `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Avatar         string         `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Root           uint64         `protobuf:"varint,5,opt,name=root,proto3" json:"root,omitempty"`
-	Parent         uint64         `protobuf:"varint,6,opt,name=parent,proto3" json:"parent,omitempty"`
-	ParentMemberId uint64         `protobuf:"varint,7,opt,name=parent_member_id,json=parentMemberId,proto3" json:"parent_member_id,omitempty"`
-	ParentNickname string         `protobuf:"bytes,8,opt,name=parent_nickname,json=parentNickname,proto3" json:"parent_nickname,omitempty"`
-	ParentAvatar   string         `protobuf:"bytes,9,opt,name=parent_avatar,json=parentAvatar,proto3" json:"parent_avatar,omitempty"`
-	Floor          int32          `protobuf:"varint,10,opt,name=floor,proto3" json:"floor,omitempty"`
-	Count          int32          `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
-	RootCount      int32          `protobuf:"varint,12,opt,name=root_count,json=rootCount,proto3" json:"root_count,omitempty"`
-	Like           int32          `protobuf:"varint,13,opt,name=like,proto3" json:"like,omitempty"`
-	Liked          bool           `protobuf:"varint,14,opt,name=liked,proto3" json:"liked,omitempty"`
-	Hate           int32          `protobuf:"varint,15,opt,name=hate,proto3" json:"hate,omitempty"`
-	State          int32          `protobuf:"varint,16,opt,name=state,proto3" json:"state,omitempty"`
-	AtMemberIds    string         `protobuf:"bytes,17,opt,name=at_member_ids,json=atMemberIds,proto3" json:"at_member_ids,omitempty"`
-	Ip             string         `protobuf:"bytes,18,opt,name=ip,proto3" json:"ip,omitempty"`
-	Platform       int32          `protobuf:"varint,19,opt,name=platform,proto3" json:"platform,omitempty"`
-	Device         string         `protobuf:"bytes,20,opt,name=device,proto3" json:"device,omitempty"`
-	Message        string         `protobuf:"bytes,21,opt,name=message,proto3" json:"message,omitempty"`
-	Meta           string         `protobuf:"bytes,22,opt,name=meta,proto3" json:"meta,omitempty"`
-	CreateAt       int64          `protobuf:"varint,23,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	UpdatedAt      int64          `protobuf:"varint,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Replies        []*CommentData `protobuf:"bytes,25,rep,name=replies,proto3" json:"replies,omitempty"`
 }
 
-func (x *CommentData) Reset() {
-	*x = CommentData{}
+func (x *ReactCommentReply) Reset() {
+	*x = ReactCommentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[11]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommentData) String() string {
+func (x *ReactCommentReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentData) ProtoMessage() {}
+func (*ReactCommentReply) ProtoMessage() {}
 
-func (x *CommentData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[11]
+func (x *ReactCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,212 +932,1090 @@ func (x *CommentData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentData.ProtoReflect.Descriptor instead.
-func (*CommentData) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReactCommentReply.ProtoReflect.Descriptor instead.
+func (*ReactCommentReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CommentData) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Meta    string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
-func (x *CommentData) GetMemberId() uint64 {
-	if x != nil {
-		return x.MemberId
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CommentData) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CommentData) GetAvatar() string {
-	if x != nil {
-		return x.Avatar
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CommentData) GetRoot() uint64 {
-	if x != nil {
-		return x.Root
-	}
-	return 0
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CommentData) GetParent() uint64 {
+func (x *UpdateCommentRequest) GetId() uint64 {
 	if x != nil {
-		return x.Parent
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CommentData) GetParentMemberId() uint64 {
+func (x *UpdateCommentRequest) GetContent() string {
 	if x != nil {
-		return x.ParentMemberId
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *CommentData) GetParentNickname() string {
+func (x *UpdateCommentRequest) GetMeta() string {
 	if x != nil {
-		return x.ParentNickname
+		return x.Meta
 	}
 	return ""
 }
 
-func (x *CommentData) GetParentAvatar() string {
+type UpdateCommentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateCommentReply) Reset() {
+	*x = UpdateCommentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentReply) ProtoMessage() {}
+
+func (x *UpdateCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentReply.ProtoReflect.Descriptor instead.
+func (*UpdateCommentReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{15}
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteCommentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCommentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCommentReply) Reset() {
+	*x = DeleteCommentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentReply) ProtoMessage() {}
+
+func (x *DeleteCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentReply.ProtoReflect.Descriptor instead.
+func (*DeleteCommentReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{17}
+}
+
+type PinCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PinCommentRequest) Reset() {
+	*x = PinCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinCommentRequest) ProtoMessage() {}
+
+func (x *PinCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinCommentRequest.ProtoReflect.Descriptor instead.
+func (*PinCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PinCommentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PinCommentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinCommentReply) Reset() {
+	*x = PinCommentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinCommentReply) ProtoMessage() {}
+
+func (x *PinCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinCommentReply.ProtoReflect.Descriptor instead.
+func (*PinCommentReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{19}
+}
+
+type UnpinCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UnpinCommentRequest) Reset() {
+	*x = UnpinCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinCommentRequest) ProtoMessage() {}
+
+func (x *UnpinCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinCommentRequest.ProtoReflect.Descriptor instead.
+func (*UnpinCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnpinCommentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnpinCommentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinCommentReply) Reset() {
+	*x = UnpinCommentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinCommentReply) ProtoMessage() {}
+
+func (x *UnpinCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinCommentReply.ProtoReflect.Descriptor instead.
+func (*UnpinCommentReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{21}
+}
+
+type CommentData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId       uint64           `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Nickname       string           `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar         string           `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Root           uint64           `protobuf:"varint,5,opt,name=root,proto3" json:"root,omitempty"`
+	Parent         uint64           `protobuf:"varint,6,opt,name=parent,proto3" json:"parent,omitempty"`
+	ParentMemberId uint64           `protobuf:"varint,7,opt,name=parent_member_id,json=parentMemberId,proto3" json:"parent_member_id,omitempty"`
+	ParentNickname string           `protobuf:"bytes,8,opt,name=parent_nickname,json=parentNickname,proto3" json:"parent_nickname,omitempty"`
+	ParentAvatar   string           `protobuf:"bytes,9,opt,name=parent_avatar,json=parentAvatar,proto3" json:"parent_avatar,omitempty"`
+	Floor          int32            `protobuf:"varint,10,opt,name=floor,proto3" json:"floor,omitempty"`
+	Count          int32            `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
+	RootCount      int32            `protobuf:"varint,12,opt,name=root_count,json=rootCount,proto3" json:"root_count,omitempty"`
+	Like           int32            `protobuf:"varint,13,opt,name=like,proto3" json:"like,omitempty"`
+	Liked          bool             `protobuf:"varint,14,opt,name=liked,proto3" json:"liked,omitempty"`
+	Hate           int32            `protobuf:"varint,15,opt,name=hate,proto3" json:"hate,omitempty"`
+	State          int32            `protobuf:"varint,16,opt,name=state,proto3" json:"state,omitempty"`
+	AtMemberIds    string           `protobuf:"bytes,17,opt,name=at_member_ids,json=atMemberIds,proto3" json:"at_member_ids,omitempty"`
+	Ip             string           `protobuf:"bytes,18,opt,name=ip,proto3" json:"ip,omitempty"`
+	Platform       int32            `protobuf:"varint,19,opt,name=platform,proto3" json:"platform,omitempty"`
+	Device         string           `protobuf:"bytes,20,opt,name=device,proto3" json:"device,omitempty"`
+	Message        string           `protobuf:"bytes,21,opt,name=message,proto3" json:"message,omitempty"`
+	Meta           string           `protobuf:"bytes,22,opt,name=meta,proto3" json:"meta,omitempty"`
+	CreateAt       int64            `protobuf:"varint,23,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	UpdatedAt      int64            `protobuf:"varint,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Replies        []*CommentData   `protobuf:"bytes,25,rep,name=replies,proto3" json:"replies,omitempty"`
+	Edited         bool             `protobuf:"varint,26,opt,name=edited,proto3" json:"edited,omitempty"`
+	EditedAt       int64            `protobuf:"varint,27,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Pinned         bool             `protobuf:"varint,28,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Reactions      map[string]int32 `protobuf:"bytes,29,rep,name=reactions,proto3" json:"reactions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	MyReaction     string           `protobuf:"bytes,30,opt,name=my_reaction,json=myReaction,proto3" json:"my_reaction,omitempty"`
+	// 内容中 @ 的成员, 客户端据此渲染链接
+	Mentions []*Mention `protobuf:"bytes,31,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// 所属主题对象, 用于从评论跳转回原内容
+	ObjId   uint64 `protobuf:"varint,32,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
+	ObjType int32  `protobuf:"varint,33,opt,name=obj_type,json=objType,proto3" json:"obj_type,omitempty"`
+	// 已发表但尚未保存, 只对作者可见
+	Queued bool `protobuf:"varint,34,opt,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (x *CommentData) Reset() {
+	*x = CommentData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentData) ProtoMessage() {}
+
+func (x *CommentData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentData.ProtoReflect.Descriptor instead.
+func (*CommentData) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CommentData) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommentData) GetMemberId() uint64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *CommentData) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *CommentData) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *CommentData) GetRoot() uint64 {
+	if x != nil {
+		return x.Root
+	}
+	return 0
+}
+
+func (x *CommentData) GetParent() uint64 {
+	if x != nil {
+		return x.Parent
+	}
+	return 0
+}
+
+func (x *CommentData) GetParentMemberId() uint64 {
+	if x != nil {
+		return x.ParentMemberId
+	}
+	return 0
+}
+
+func (x *CommentData) GetParentNickname() string {
+	if x != nil {
+		return x.ParentNickname
+	}
+	return ""
+}
+
+func (x *CommentData) GetParentAvatar() string {
+	if x != nil {
+		return x.ParentAvatar
+	}
+	return ""
+}
+
+func (x *CommentData) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *CommentData) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CommentData) GetRootCount() int32 {
+	if x != nil {
+		return x.RootCount
+	}
+	return 0
+}
+
+func (x *CommentData) GetLike() int32 {
+	if x != nil {
+		return x.Like
+	}
+	return 0
+}
+
+func (x *CommentData) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *CommentData) GetHate() int32 {
+	if x != nil {
+		return x.Hate
+	}
+	return 0
+}
+
+func (x *CommentData) GetState() int32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *CommentData) GetAtMemberIds() string {
+	if x != nil {
+		return x.AtMemberIds
+	}
+	return ""
+}
+
+func (x *CommentData) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CommentData) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *CommentData) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CommentData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommentData) GetMeta() string {
 	if x != nil {
-		return x.ParentAvatar
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *CommentData) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *CommentData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *CommentData) GetReplies() []*CommentData {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *CommentData) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *CommentData) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+func (x *CommentData) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *CommentData) GetReactions() map[string]int32 {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *CommentData) GetMyReaction() string {
+	if x != nil {
+		return x.MyReaction
+	}
+	return ""
+}
+
+func (x *CommentData) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *CommentData) GetObjId() uint64 {
+	if x != nil {
+		return x.ObjId
+	}
+	return 0
+}
+
+func (x *CommentData) GetObjType() int32 {
+	if x != nil {
+		return x.ObjType
+	}
+	return 0
+}
+
+func (x *CommentData) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+// Mention 评论中 @ 的成员, start 与 end 为 @昵称 在 message 中的字符下标, 左闭右开
+type Mention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId uint64 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Start    int32  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End      int32  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Mention) GetMemberId() uint64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *Mention) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Mention) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LoginRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string       `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Account *AccountInfo `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *LoginReply) Reset() {
+	*x = LoginReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReply) ProtoMessage() {}
+
+func (x *LoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
+func (*LoginReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LoginReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginReply) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AccountInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar   string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+}
+
+func (x *AccountInfo) Reset() {
+	*x = AccountInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInfo) ProtoMessage() {}
+
+func (x *AccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CommentData) GetFloor() int32 {
+// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
+func (*AccountInfo) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AccountInfo) GetId() uint64 {
 	if x != nil {
-		return x.Floor
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CommentData) GetCount() int32 {
+func (x *AccountInfo) GetNickname() string {
 	if x != nil {
-		return x.Count
+		return x.Nickname
 	}
-	return 0
+	return ""
 }
 
-func (x *CommentData) GetRootCount() int32 {
+func (x *AccountInfo) GetAvatar() string {
 	if x != nil {
-		return x.RootCount
+		return x.Avatar
 	}
-	return 0
+	return ""
 }
 
-func (x *CommentData) GetLike() int32 {
-	if x != nil {
-		return x.Like
+type ListNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListNotificationRequest) Reset() {
+	*x = ListNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CommentData) GetLiked() bool {
-	if x != nil {
-		return x.Liked
+func (x *ListNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationRequest) ProtoMessage() {}
+
+func (x *ListNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CommentData) GetHate() int32 {
+// Deprecated: Use ListNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListNotificationRequest) GetPage() int32 {
 	if x != nil {
-		return x.Hate
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CommentData) GetState() int32 {
+func (x *ListNotificationRequest) GetSize() int32 {
 	if x != nil {
-		return x.State
+		return x.Size
 	}
 	return 0
 }
 
-func (x *CommentData) GetAtMemberIds() string {
-	if x != nil {
-		return x.AtMemberIds
-	}
-	return ""
+type ListNotificationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*NotificationData `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
 }
 
-func (x *CommentData) GetIp() string {
-	if x != nil {
-		return x.Ip
+func (x *ListNotificationReply) Reset() {
+	*x = ListNotificationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CommentData) GetPlatform() int32 {
-	if x != nil {
-		return x.Platform
-	}
-	return 0
+func (x *ListNotificationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CommentData) GetDevice() string {
-	if x != nil {
-		return x.Device
+func (*ListNotificationReply) ProtoMessage() {}
+
+func (x *ListNotificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CommentData) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use ListNotificationReply.ProtoReflect.Descriptor instead.
+func (*ListNotificationReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CommentData) GetMeta() string {
+func (x *ListNotificationReply) GetNotifications() []*NotificationData {
 	if x != nil {
-		return x.Meta
+		return x.Notifications
 	}
-	return ""
+	return nil
 }
 
-func (x *CommentData) GetCreateAt() int64 {
-	if x != nil {
-		return x.CreateAt
+type ReadNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ReadNotificationRequest) Reset() {
+	*x = ReadNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CommentData) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
+func (x *ReadNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadNotificationRequest) ProtoMessage() {}
+
+func (x *ReadNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CommentData) GetReplies() []*CommentData {
+// Deprecated: Use ReadNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ReadNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReadNotificationRequest) GetIds() []uint64 {
 	if x != nil {
-		return x.Replies
+		return x.Ids
 	}
 	return nil
 }
 
-type LoginRequest struct {
+type ReadNotificationReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *ReadNotificationReply) Reset() {
+	*x = ReadNotificationReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[12]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginRequest) String() string {
+func (x *ReadNotificationReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*ReadNotificationReply) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[12]
+func (x *ReadNotificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,51 +2026,74 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ReadNotificationReply.ProtoReflect.Descriptor instead.
+func (*ReadNotificationReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *LoginRequest) GetAccount() string {
-	if x != nil {
-		return x.Account
+type GetUnreadNotificationCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUnreadNotificationCountRequest) Reset() {
+	*x = GetUnreadNotificationCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *LoginRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *GetUnreadNotificationCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type LoginReply struct {
+// Deprecated: Use GetUnreadNotificationCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountRequest) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{31}
+}
+
+type GetUnreadNotificationCountReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token   string       `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Account *AccountInfo `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *LoginReply) Reset() {
-	*x = LoginReply{}
+func (x *GetUnreadNotificationCountReply) Reset() {
+	*x = GetUnreadNotificationCountReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[13]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginReply) String() string {
+func (x *GetUnreadNotificationCountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginReply) ProtoMessage() {}
+func (*GetUnreadNotificationCountReply) ProtoMessage() {}
 
-func (x *LoginReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[13]
+func (x *GetUnreadNotificationCountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,52 +2104,57 @@ func (x *LoginReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
-func (*LoginReply) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *LoginReply) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use GetUnreadNotificationCountReply.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountReply) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *LoginReply) GetAccount() *AccountInfo {
+func (x *GetUnreadNotificationCountReply) GetCount() int32 {
 	if x != nil {
-		return x.Account
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type AccountInfo struct {
+type NotificationData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Avatar   string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-}
-
-func (x *AccountInfo) Reset() {
-	*x = AccountInfo{}
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 1 回复, 2 @, 3 点赞
+	Type      int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	CommentId uint64 `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	ObjId     uint64 `protobuf:"varint,4,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
+	ObjType   int32  `protobuf:"varint,5,opt,name=obj_type,json=objType,proto3" json:"obj_type,omitempty"`
+	// 最近一次触发通知的成员
+	ActorId       uint64 `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorNickname string `protobuf:"bytes,7,opt,name=actor_nickname,json=actorNickname,proto3" json:"actor_nickname,omitempty"`
+	ActorAvatar   string `protobuf:"bytes,8,opt,name=actor_avatar,json=actorAvatar,proto3" json:"actor_avatar,omitempty"`
+	// 触发通知的成员数, 如 "X 等 12 人赞了你的评论"
+	ActorCount int32 `protobuf:"varint,9,opt,name=actor_count,json=actorCount,proto3" json:"actor_count,omitempty"`
+	Read       bool  `protobuf:"varint,10,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt  int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  int64 `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *NotificationData) Reset() {
+	*x = NotificationData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[14]
+		mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccountInfo) String() string {
+func (x *NotificationData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountInfo) ProtoMessage() {}
+func (*NotificationData) ProtoMessage() {}
 
-func (x *AccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[14]
+func (x *NotificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,30 +2165,93 @@ func (x *AccountInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
-func (*AccountInfo) Descriptor() ([]byte, []int) {
-	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use NotificationData.ProtoReflect.Descriptor instead.
+func (*NotificationData) Descriptor() ([]byte, []int) {
+	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NotificationData) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NotificationData) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *NotificationData) GetCommentId() uint64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *NotificationData) GetObjId() uint64 {
+	if x != nil {
+		return x.ObjId
+	}
+	return 0
+}
+
+func (x *NotificationData) GetObjType() int32 {
+	if x != nil {
+		return x.ObjType
+	}
+	return 0
+}
+
+func (x *NotificationData) GetActorId() uint64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *NotificationData) GetActorNickname() string {
+	if x != nil {
+		return x.ActorNickname
+	}
+	return ""
+}
+
+func (x *NotificationData) GetActorAvatar() string {
+	if x != nil {
+		return x.ActorAvatar
+	}
+	return ""
 }
 
-func (x *AccountInfo) GetId() uint64 {
+func (x *NotificationData) GetActorCount() int32 {
 	if x != nil {
-		return x.Id
+		return x.ActorCount
 	}
 	return 0
 }
 
-func (x *AccountInfo) GetNickname() string {
+func (x *NotificationData) GetRead() bool {
 	if x != nil {
-		return x.Nickname
+		return x.Read
 	}
-	return ""
+	return false
 }
 
-func (x *AccountInfo) GetAvatar() string {
+func (x *NotificationData) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Avatar
+		return x.CreatedAt
 	}
-	return ""
+	return 0
+}
+
+func (x *NotificationData) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
 }
 
 var File_api_baseapp_interface_v1_baseapp_interface_proto protoreflect.FileDescriptor
@@ -1133,7 +2278,7 @@ var file_api_baseapp_interface_v1_baseapp_interface_proto_rawDesc = []byte{
 	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x62, 0x6a,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x62, 0x6a, 0x49, 0x64,
 	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x54, 0x79, 0x70, 0x65, 0x22, 0xfe, 0x01, 0x0a, 0x16,
+	0x28, 0x05, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbf, 0x04, 0x0a, 0x16,
 	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
 	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x5f, 0x69, 0x64,
@@ -1149,167 +2294,382 @@ var file_api_baseapp_interface_v1_baseapp_interface_proto_rawDesc = []byte{
 	0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
 	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x22, 0x0a, 0x10,
-	0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x62, 0x6a, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62,
-	0x6a, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x62,
-	0x6a, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x08, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x56, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c,
-	0x69, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x22,
-	0x12, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0xc4, 0x05, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76,
-	0x61, 0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x76,
-	0x61, 0x74, 0x61, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f,
-	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x61, 0x74,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x74, 0x5f, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x60, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x65, 0x70, 0x74, 0x68, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22, 0x0a,
+	0x10, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
 	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x0c, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x63, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x61, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc2, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x62, 0x6a, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6f, 0x62, 0x6a, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x41,
+	0x0a, 0x13, 0x52, 0x65, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x54, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x14, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x23, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x0a, 0x13, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x13, 0x0a, 0x11, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0xcd, 0x08, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x74, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x79, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x0a, 0x06,
+	0x6f, 0x62, 0x6a, 0x5f, 0x69, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x62,
+	0x6a, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4e, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x63, 0x0a, 0x0a, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x51, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x22, 0x41, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x69, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x50,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65,
 	0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x32, 0xc2, 0x07, 0x0a, 0x10, 0x42, 0x61, 0x73,
-	0x65, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x97, 0x01,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70,
-	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x2b, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x17, 0x0a,
+	0x15, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6f, 0x62, 0x6a, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x62,
+	0x6a, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xff, 0x11, 0x0a, 0x10, 0x42, 0x61, 0x73, 0x65, 0x61,
+	0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x7d, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x14,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x8b, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x7d, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
+	0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x88, 0x01,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x8b, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
-	0x6c, 0x69, 0x73, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65,
 	0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61,
 	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x7f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x12, 0x87, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x1a, 0x11, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x0a, 0x50, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x89,
+	0x01, 0x0a, 0x0c, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x18, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a, 0x05, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70,
-	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x70, 0x69, 0x6e, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x46, 0x0a,
-	0x18, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x28, 0x62, 0x61, 0x73,
-	0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x73, 0x65, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x12, 0xb6, 0x01, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x12, 0x71, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x26,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x46, 0x0a, 0x18, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x28, 0x62, 0x61, 0x73, 0x65, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1324,48 +2684,91 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescGZIP() []byte
 	return file_api_baseapp_interface_v1_baseapp_interface_proto_rawDescData
 }
 
-var file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_api_baseapp_interface_v1_baseapp_interface_proto_goTypes = []interface{}{
-	(*SaveCommentRequest)(nil),       // 0: api.baseapp.interface.v1.SaveCommentRequest
-	(*GetCommentSubjectRequest)(nil), // 1: api.baseapp.interface.v1.GetCommentSubjectRequest
-	(*GetCommentSubjectReply)(nil),   // 2: api.baseapp.interface.v1.GetCommentSubjectReply
-	(*SaveCommentReply)(nil),         // 3: api.baseapp.interface.v1.SaveCommentReply
-	(*GetCommentRequest)(nil),        // 4: api.baseapp.interface.v1.GetCommentRequest
-	(*GetCommentReply)(nil),          // 5: api.baseapp.interface.v1.GetCommentReply
-	(*GetCommentListRequest)(nil),    // 6: api.baseapp.interface.v1.GetCommentListRequest
-	(*GetCommentListReply)(nil),      // 7: api.baseapp.interface.v1.GetCommentListReply
-	(*GetReplyListRequest)(nil),      // 8: api.baseapp.interface.v1.GetReplyListRequest
-	(*LikeCommentRequest)(nil),       // 9: api.baseapp.interface.v1.LikeCommentRequest
-	(*LikeCommentReply)(nil),         // 10: api.baseapp.interface.v1.LikeCommentReply
-	(*CommentData)(nil),              // 11: api.baseapp.interface.v1.CommentData
-	(*LoginRequest)(nil),             // 12: api.baseapp.interface.v1.LoginRequest
-	(*LoginReply)(nil),               // 13: api.baseapp.interface.v1.LoginReply
-	(*AccountInfo)(nil),              // 14: api.baseapp.interface.v1.AccountInfo
+	(*SaveCommentRequest)(nil),                // 0: api.baseapp.interface.v1.SaveCommentRequest
+	(*GetCommentSubjectRequest)(nil),          // 1: api.baseapp.interface.v1.GetCommentSubjectRequest
+	(*GetCommentSubjectReply)(nil),            // 2: api.baseapp.interface.v1.GetCommentSubjectReply
+	(*SaveCommentReply)(nil),                  // 3: api.baseapp.interface.v1.SaveCommentReply
+	(*GetCommentRequest)(nil),                 // 4: api.baseapp.interface.v1.GetCommentRequest
+	(*GetCommentReply)(nil),                   // 5: api.baseapp.interface.v1.GetCommentReply
+	(*GetCommentStatusRequest)(nil),           // 6: api.baseapp.interface.v1.GetCommentStatusRequest
+	(*GetCommentStatusReply)(nil),             // 7: api.baseapp.interface.v1.GetCommentStatusReply
+	(*GetCommentListRequest)(nil),             // 8: api.baseapp.interface.v1.GetCommentListRequest
+	(*GetCommentListReply)(nil),               // 9: api.baseapp.interface.v1.GetCommentListReply
+	(*GetReplyListRequest)(nil),               // 10: api.baseapp.interface.v1.GetReplyListRequest
+	(*ListMyCommentRequest)(nil),              // 11: api.baseapp.interface.v1.ListMyCommentRequest
+	(*ReactCommentRequest)(nil),               // 12: api.baseapp.interface.v1.ReactCommentRequest
+	(*ReactCommentReply)(nil),                 // 13: api.baseapp.interface.v1.ReactCommentReply
+	(*UpdateCommentRequest)(nil),              // 14: api.baseapp.interface.v1.UpdateCommentRequest
+	(*UpdateCommentReply)(nil),                // 15: api.baseapp.interface.v1.UpdateCommentReply
+	(*DeleteCommentRequest)(nil),              // 16: api.baseapp.interface.v1.DeleteCommentRequest
+	(*DeleteCommentReply)(nil),                // 17: api.baseapp.interface.v1.DeleteCommentReply
+	(*PinCommentRequest)(nil),                 // 18: api.baseapp.interface.v1.PinCommentRequest
+	(*PinCommentReply)(nil),                   // 19: api.baseapp.interface.v1.PinCommentReply
+	(*UnpinCommentRequest)(nil),               // 20: api.baseapp.interface.v1.UnpinCommentRequest
+	(*UnpinCommentReply)(nil),                 // 21: api.baseapp.interface.v1.UnpinCommentReply
+	(*CommentData)(nil),                       // 22: api.baseapp.interface.v1.CommentData
+	(*Mention)(nil),                           // 23: api.baseapp.interface.v1.Mention
+	(*LoginRequest)(nil),                      // 24: api.baseapp.interface.v1.LoginRequest
+	(*LoginReply)(nil),                        // 25: api.baseapp.interface.v1.LoginReply
+	(*AccountInfo)(nil),                       // 26: api.baseapp.interface.v1.AccountInfo
+	(*ListNotificationRequest)(nil),           // 27: api.baseapp.interface.v1.ListNotificationRequest
+	(*ListNotificationReply)(nil),             // 28: api.baseapp.interface.v1.ListNotificationReply
+	(*ReadNotificationRequest)(nil),           // 29: api.baseapp.interface.v1.ReadNotificationRequest
+	(*ReadNotificationReply)(nil),             // 30: api.baseapp.interface.v1.ReadNotificationReply
+	(*GetUnreadNotificationCountRequest)(nil), // 31: api.baseapp.interface.v1.GetUnreadNotificationCountRequest
+	(*GetUnreadNotificationCountReply)(nil),   // 32: api.baseapp.interface.v1.GetUnreadNotificationCountReply
+	(*NotificationData)(nil),                  // 33: api.baseapp.interface.v1.NotificationData
+	nil,                                       // 34: api.baseapp.interface.v1.GetCommentSubjectReply.AttributesEntry
+	nil,                                       // 35: api.baseapp.interface.v1.CommentData.ReactionsEntry
 }
 var file_api_baseapp_interface_v1_baseapp_interface_proto_depIdxs = []int32{
-	11, // 0: api.baseapp.interface.v1.GetCommentReply.comment:type_name -> api.baseapp.interface.v1.CommentData
-	11, // 1: api.baseapp.interface.v1.GetCommentListReply.comments:type_name -> api.baseapp.interface.v1.CommentData
-	11, // 2: api.baseapp.interface.v1.CommentData.replies:type_name -> api.baseapp.interface.v1.CommentData
-	14, // 3: api.baseapp.interface.v1.LoginReply.account:type_name -> api.baseapp.interface.v1.AccountInfo
-	1,  // 4: api.baseapp.interface.v1.BaseappInterface.GetCommentSubject:input_type -> api.baseapp.interface.v1.GetCommentSubjectRequest
-	0,  // 5: api.baseapp.interface.v1.BaseappInterface.SaveComment:input_type -> api.baseapp.interface.v1.SaveCommentRequest
-	6,  // 6: api.baseapp.interface.v1.BaseappInterface.GetCommentList:input_type -> api.baseapp.interface.v1.GetCommentListRequest
-	8,  // 7: api.baseapp.interface.v1.BaseappInterface.GetReplyList:input_type -> api.baseapp.interface.v1.GetReplyListRequest
-	4,  // 8: api.baseapp.interface.v1.BaseappInterface.GetComment:input_type -> api.baseapp.interface.v1.GetCommentRequest
-	9,  // 9: api.baseapp.interface.v1.BaseappInterface.LikeComment:input_type -> api.baseapp.interface.v1.LikeCommentRequest
-	12, // 10: api.baseapp.interface.v1.BaseappInterface.Login:input_type -> api.baseapp.interface.v1.LoginRequest
-	2,  // 11: api.baseapp.interface.v1.BaseappInterface.GetCommentSubject:output_type -> api.baseapp.interface.v1.GetCommentSubjectReply
-	3,  // 12: api.baseapp.interface.v1.BaseappInterface.SaveComment:output_type -> api.baseapp.interface.v1.SaveCommentReply
-	7,  // 13: api.baseapp.interface.v1.BaseappInterface.GetCommentList:output_type -> api.baseapp.interface.v1.GetCommentListReply
-	7,  // 14: api.baseapp.interface.v1.BaseappInterface.GetReplyList:output_type -> api.baseapp.interface.v1.GetCommentListReply
-	5,  // 15: api.baseapp.interface.v1.BaseappInterface.GetComment:output_type -> api.baseapp.interface.v1.GetCommentReply
-	10, // 16: api.baseapp.interface.v1.BaseappInterface.LikeComment:output_type -> api.baseapp.interface.v1.LikeCommentReply
-	13, // 17: api.baseapp.interface.v1.BaseappInterface.Login:output_type -> api.baseapp.interface.v1.LoginReply
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	34, // 0: api.baseapp.interface.v1.GetCommentSubjectReply.attributes:type_name -> api.baseapp.interface.v1.GetCommentSubjectReply.AttributesEntry
+	22, // 1: api.baseapp.interface.v1.GetCommentReply.comment:type_name -> api.baseapp.interface.v1.CommentData
+	22, // 2: api.baseapp.interface.v1.GetCommentListReply.comments:type_name -> api.baseapp.interface.v1.CommentData
+	22, // 3: api.baseapp.interface.v1.CommentData.replies:type_name -> api.baseapp.interface.v1.CommentData
+	35, // 4: api.baseapp.interface.v1.CommentData.reactions:type_name -> api.baseapp.interface.v1.CommentData.ReactionsEntry
+	23, // 5: api.baseapp.interface.v1.CommentData.mentions:type_name -> api.baseapp.interface.v1.Mention
+	26, // 6: api.baseapp.interface.v1.LoginReply.account:type_name -> api.baseapp.interface.v1.AccountInfo
+	33, // 7: api.baseapp.interface.v1.ListNotificationReply.notifications:type_name -> api.baseapp.interface.v1.NotificationData
+	1,  // 8: api.baseapp.interface.v1.BaseappInterface.GetCommentSubject:input_type -> api.baseapp.interface.v1.GetCommentSubjectRequest
+	0,  // 9: api.baseapp.interface.v1.BaseappInterface.SaveComment:input_type -> api.baseapp.interface.v1.SaveCommentRequest
+	8,  // 10: api.baseapp.interface.v1.BaseappInterface.GetCommentList:input_type -> api.baseapp.interface.v1.GetCommentListRequest
+	10, // 11: api.baseapp.interface.v1.BaseappInterface.GetReplyList:input_type -> api.baseapp.interface.v1.GetReplyListRequest
+	11, // 12: api.baseapp.interface.v1.BaseappInterface.ListMyComment:input_type -> api.baseapp.interface.v1.ListMyCommentRequest
+	4,  // 13: api.baseapp.interface.v1.BaseappInterface.GetComment:input_type -> api.baseapp.interface.v1.GetCommentRequest
+	6,  // 14: api.baseapp.interface.v1.BaseappInterface.GetCommentStatus:input_type -> api.baseapp.interface.v1.GetCommentStatusRequest
+	12, // 15: api.baseapp.interface.v1.BaseappInterface.ReactComment:input_type -> api.baseapp.interface.v1.ReactCommentRequest
+	14, // 16: api.baseapp.interface.v1.BaseappInterface.UpdateComment:input_type -> api.baseapp.interface.v1.UpdateCommentRequest
+	16, // 17: api.baseapp.interface.v1.BaseappInterface.DeleteComment:input_type -> api.baseapp.interface.v1.DeleteCommentRequest
+	18, // 18: api.baseapp.interface.v1.BaseappInterface.PinComment:input_type -> api.baseapp.interface.v1.PinCommentRequest
+	20, // 19: api.baseapp.interface.v1.BaseappInterface.UnpinComment:input_type -> api.baseapp.interface.v1.UnpinCommentRequest
+	27, // 20: api.baseapp.interface.v1.BaseappInterface.ListNotification:input_type -> api.baseapp.interface.v1.ListNotificationRequest
+	29, // 21: api.baseapp.interface.v1.BaseappInterface.ReadNotification:input_type -> api.baseapp.interface.v1.ReadNotificationRequest
+	31, // 22: api.baseapp.interface.v1.BaseappInterface.GetUnreadNotificationCount:input_type -> api.baseapp.interface.v1.GetUnreadNotificationCountRequest
+	24, // 23: api.baseapp.interface.v1.BaseappInterface.Login:input_type -> api.baseapp.interface.v1.LoginRequest
+	2,  // 24: api.baseapp.interface.v1.BaseappInterface.GetCommentSubject:output_type -> api.baseapp.interface.v1.GetCommentSubjectReply
+	3,  // 25: api.baseapp.interface.v1.BaseappInterface.SaveComment:output_type -> api.baseapp.interface.v1.SaveCommentReply
+	9,  // 26: api.baseapp.interface.v1.BaseappInterface.GetCommentList:output_type -> api.baseapp.interface.v1.GetCommentListReply
+	9,  // 27: api.baseapp.interface.v1.BaseappInterface.GetReplyList:output_type -> api.baseapp.interface.v1.GetCommentListReply
+	9,  // 28: api.baseapp.interface.v1.BaseappInterface.ListMyComment:output_type -> api.baseapp.interface.v1.GetCommentListReply
+	5,  // 29: api.baseapp.interface.v1.BaseappInterface.GetComment:output_type -> api.baseapp.interface.v1.GetCommentReply
+	7,  // 30: api.baseapp.interface.v1.BaseappInterface.GetCommentStatus:output_type -> api.baseapp.interface.v1.GetCommentStatusReply
+	13, // 31: api.baseapp.interface.v1.BaseappInterface.ReactComment:output_type -> api.baseapp.interface.v1.ReactCommentReply
+	15, // 32: api.baseapp.interface.v1.BaseappInterface.UpdateComment:output_type -> api.baseapp.interface.v1.UpdateCommentReply
+	17, // 33: api.baseapp.interface.v1.BaseappInterface.DeleteComment:output_type -> api.baseapp.interface.v1.DeleteCommentReply
+	19, // 34: api.baseapp.interface.v1.BaseappInterface.PinComment:output_type -> api.baseapp.interface.v1.PinCommentReply
+	21, // 35: api.baseapp.interface.v1.BaseappInterface.UnpinComment:output_type -> api.baseapp.interface.v1.UnpinCommentReply
+	28, // 36: api.baseapp.interface.v1.BaseappInterface.ListNotification:output_type -> api.baseapp.interface.v1.ListNotificationReply
+	30, // 37: api.baseapp.interface.v1.BaseappInterface.ReadNotification:output_type -> api.baseapp.interface.v1.ReadNotificationReply
+	32, // 38: api.baseapp.interface.v1.BaseappInterface.GetUnreadNotificationCount:output_type -> api.baseapp.interface.v1.GetUnreadNotificationCountReply
+	25, // 39: api.baseapp.interface.v1.BaseappInterface.Login:output_type -> api.baseapp.interface.v1.LoginReply
+	24, // [24:40] is the sub-list for method output_type
+	8,  // [8:24] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_baseapp_interface_v1_baseapp_interface_proto_init() }
@@ -1447,7 +2850,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommentListRequest); i {
+			switch v := v.(*GetCommentStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1459,7 +2862,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommentListReply); i {
+			switch v := v.(*GetCommentStatusReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1471,7 +2874,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReplyListRequest); i {
+			switch v := v.(*GetCommentListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1483,7 +2886,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeCommentRequest); i {
+			switch v := v.(*GetCommentListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1495,7 +2898,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeCommentReply); i {
+			switch v := v.(*GetReplyListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1507,7 +2910,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentData); i {
+			switch v := v.(*ListMyCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1519,7 +2922,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+			switch v := v.(*ReactCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1531,7 +2934,7 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReply); i {
+			switch v := v.(*ReactCommentReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1543,6 +2946,150 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 			}
 		}
 		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCommentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinCommentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinCommentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccountInfo); i {
 			case 0:
 				return &v.state
@@ -1554,14 +3101,99 @@ func file_api_baseapp_interface_v1_baseapp_interface_proto_init() {
 				return nil
 			}
 		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotificationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnreadNotificationCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnreadNotificationCountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_api_baseapp_interface_v1_baseapp_interface_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_baseapp_interface_v1_baseapp_interface_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
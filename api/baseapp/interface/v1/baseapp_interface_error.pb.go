@@ -28,21 +28,44 @@ const (
 	BaseappInterfaceError_CONTENT_MISSING             BaseappInterfaceError = 1
 	BaseappInterfaceError_INVALID_ACCOUNT_OR_PASSWORD BaseappInterfaceError = 2
 	BaseappInterfaceError_UNAUTHORIZED                BaseappInterfaceError = 3
+	BaseappInterfaceError_INVALID_ARGUMENT            BaseappInterfaceError = 4
+	BaseappInterfaceError_SUBJECT_CLOSED              BaseappInterfaceError = 5
+	BaseappInterfaceError_RATE_LIMITED                BaseappInterfaceError = 6
+	BaseappInterfaceError_IDEMPOTENCY_KEY_REUSED      BaseappInterfaceError = 7
+	BaseappInterfaceError_REQUEST_IN_PROGRESS         BaseappInterfaceError = 8
+	// 超过可编辑的时间
+	BaseappInterfaceError_EDIT_WINDOW_EXPIRED BaseappInterfaceError = 9
+	// 评论当前的状态不允许该操作
+	BaseappInterfaceError_INVALID_STATE BaseappInterfaceError = 10
 )
 
 // Enum value maps for BaseappInterfaceError.
 var (
 	BaseappInterfaceError_name = map[int32]string{
-		0: "INFO_NOT_FOUND",
-		1: "CONTENT_MISSING",
-		2: "INVALID_ACCOUNT_OR_PASSWORD",
-		3: "UNAUTHORIZED",
+		0:  "INFO_NOT_FOUND",
+		1:  "CONTENT_MISSING",
+		2:  "INVALID_ACCOUNT_OR_PASSWORD",
+		3:  "UNAUTHORIZED",
+		4:  "INVALID_ARGUMENT",
+		5:  "SUBJECT_CLOSED",
+		6:  "RATE_LIMITED",
+		7:  "IDEMPOTENCY_KEY_REUSED",
+		8:  "REQUEST_IN_PROGRESS",
+		9:  "EDIT_WINDOW_EXPIRED",
+		10: "INVALID_STATE",
 	}
 	BaseappInterfaceError_value = map[string]int32{
 		"INFO_NOT_FOUND":              0,
 		"CONTENT_MISSING":             1,
 		"INVALID_ACCOUNT_OR_PASSWORD": 2,
 		"UNAUTHORIZED":                3,
+		"INVALID_ARGUMENT":            4,
+		"SUBJECT_CLOSED":              5,
+		"RATE_LIMITED":                6,
+		"IDEMPOTENCY_KEY_REUSED":      7,
+		"REQUEST_IN_PROGRESS":         8,
+		"EDIT_WINDOW_EXPIRED":         9,
+		"INVALID_STATE":               10,
 	}
 )
 
@@ -82,7 +105,7 @@ var file_api_baseapp_interface_v1_baseapp_interface_error_proto_rawDesc = []byte
 	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e,
 	0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x91, 0x01, 0x0a, 0x15, 0x42, 0x61, 0x73, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xd8, 0x02, 0x0a, 0x15, 0x42, 0x61, 0x73, 0x65,
 	0x61, 0x70, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x12, 0x18, 0x0a, 0x0e, 0x49, 0x4e, 0x46, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
 	0x55, 0x4e, 0x44, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x43,
@@ -91,12 +114,25 @@ var file_api_baseapp_interface_v1_baseapp_interface_error_proto_rawDesc = []byte
 	0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x53,
 	0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0xf5, 0x03, 0x12, 0x16, 0x0a,
 	0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x03, 0x1a,
-	0x04, 0xa8, 0x45, 0x93, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x46, 0x0a, 0x18, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x28, 0x62, 0x61, 0x73, 0x65, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x73, 0x65,
-	0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x90,
+	0x03, 0x12, 0x18, 0x0a, 0x0e, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x52,
+	0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x06, 0x1a, 0x04, 0xa8,
+	0x45, 0xad, 0x03, 0x12, 0x20, 0x0a, 0x16, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x45, 0x55, 0x53, 0x45, 0x44, 0x10, 0x07, 0x1a,
+	0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1d, 0x0a, 0x13, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x08, 0x1a, 0x04,
+	0xa8, 0x45, 0x99, 0x03, 0x12, 0x1d, 0x0a, 0x13, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x09, 0x1a, 0x04, 0xa8,
+	0x45, 0x99, 0x03, 0x12, 0x17, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x10, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x1a, 0x04, 0xa0, 0x45,
+	0xf4, 0x03, 0x42, 0x46, 0x0a, 0x18, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01,
+	0x5a, 0x28, 0x62, 0x61, 0x73, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
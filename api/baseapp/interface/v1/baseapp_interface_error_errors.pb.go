@@ -46,3 +46,66 @@ func IsUNAUTHORIZED(err error) bool {
 func ErrorUNAUTHORIZED(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, BaseappInterfaceError_UNAUTHORIZED.String(), fmt.Sprintf(format, args...))
 }
+
+func IsInvalidArgument(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == BaseappInterfaceError_INVALID_ARGUMENT.String() && e.Code == 400
+}
+
+func ErrorInvalidArgument(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, BaseappInterfaceError_INVALID_ARGUMENT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSubjectClosed(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == BaseappInterfaceError_SUBJECT_CLOSED.String() && e.Code == 403
+}
+
+func ErrorSubjectClosed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, BaseappInterfaceError_SUBJECT_CLOSED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRateLimited(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == BaseappInterfaceError_RATE_LIMITED.String() && e.Code == 429
+}
+
+func ErrorRateLimited(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, BaseappInterfaceError_RATE_LIMITED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsIdempotencyKeyReused(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == BaseappInterfaceError_IDEMPOTENCY_KEY_REUSED.String() && e.Code == 409
+}
+
+func ErrorIdempotencyKeyReused(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, BaseappInterfaceError_IDEMPOTENCY_KEY_REUSED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRequestInProgress(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == BaseappInterfaceError_REQUEST_IN_PROGRESS.String() && e.Code == 409
+}
+
+func ErrorRequestInProgress(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, BaseappInterfaceError_REQUEST_IN_PROGRESS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEditWindowExpired(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == BaseappInterfaceError_EDIT_WINDOW_EXPIRED.String() && e.Code == 409
+}
+
+func ErrorEditWindowExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, BaseappInterfaceError_EDIT_WINDOW_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidState(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == BaseappInterfaceError_INVALID_STATE.String() && e.Code == 409
+}
+
+func ErrorInvalidState(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, BaseappInterfaceError_INVALID_STATE.String(), fmt.Sprintf(format, args...))
+}
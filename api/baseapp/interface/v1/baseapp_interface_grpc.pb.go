@@ -22,8 +22,23 @@ type BaseappInterfaceClient interface {
 	SaveComment(ctx context.Context, in *SaveCommentRequest, opts ...grpc.CallOption) (*SaveCommentReply, error)
 	GetCommentList(ctx context.Context, in *GetCommentListRequest, opts ...grpc.CallOption) (*GetCommentListReply, error)
 	GetReplyList(ctx context.Context, in *GetReplyListRequest, opts ...grpc.CallOption) (*GetCommentListReply, error)
+	// 当前用户发表的评论
+	ListMyComment(ctx context.Context, in *ListMyCommentRequest, opts ...grpc.CallOption) (*GetCommentListReply, error)
 	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentReply, error)
-	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentReply, error)
+	// 发表的评论由后台异步保存, 客户端据此确认评论是否保存成功, 只能查询自己发表的评论
+	// 保存失败的评论在等待保存的有效期内仍为等待保存, 过期后返回评论不存在
+	GetCommentStatus(ctx context.Context, in *GetCommentStatusRequest, opts ...grpc.CallOption) (*GetCommentStatusReply, error)
+	ReactComment(ctx context.Context, in *ReactCommentRequest, opts ...grpc.CallOption) (*ReactCommentReply, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentReply, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentReply, error)
+	PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentReply, error)
+	UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...grpc.CallOption) (*UnpinCommentReply, error)
+	// 当前用户收到的通知
+	ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (*ListNotificationReply, error)
+	// 将通知标记为已读, ids 为空时全部标记为已读
+	ReadNotification(ctx context.Context, in *ReadNotificationRequest, opts ...grpc.CallOption) (*ReadNotificationReply, error)
+	// 未读通知数, 用于显示角标
+	GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadNotificationCountReply, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
 }
 
@@ -71,6 +86,15 @@ func (c *baseappInterfaceClient) GetReplyList(ctx context.Context, in *GetReplyL
 	return out, nil
 }
 
+func (c *baseappInterfaceClient) ListMyComment(ctx context.Context, in *ListMyCommentRequest, opts ...grpc.CallOption) (*GetCommentListReply, error) {
+	out := new(GetCommentListReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/ListMyComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *baseappInterfaceClient) GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentReply, error) {
 	out := new(GetCommentReply)
 	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/GetComment", in, out, opts...)
@@ -80,9 +104,81 @@ func (c *baseappInterfaceClient) GetComment(ctx context.Context, in *GetCommentR
 	return out, nil
 }
 
-func (c *baseappInterfaceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentReply, error) {
-	out := new(LikeCommentReply)
-	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/LikeComment", in, out, opts...)
+func (c *baseappInterfaceClient) GetCommentStatus(ctx context.Context, in *GetCommentStatusRequest, opts ...grpc.CallOption) (*GetCommentStatusReply, error) {
+	out := new(GetCommentStatusReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/GetCommentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) ReactComment(ctx context.Context, in *ReactCommentRequest, opts ...grpc.CallOption) (*ReactCommentReply, error) {
+	out := new(ReactCommentReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/ReactComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentReply, error) {
+	out := new(UpdateCommentReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/UpdateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentReply, error) {
+	out := new(DeleteCommentReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentReply, error) {
+	out := new(PinCommentReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/PinComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...grpc.CallOption) (*UnpinCommentReply, error) {
+	out := new(UnpinCommentReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/UnpinComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (*ListNotificationReply, error) {
+	out := new(ListNotificationReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/ListNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) ReadNotification(ctx context.Context, in *ReadNotificationRequest, opts ...grpc.CallOption) (*ReadNotificationReply, error) {
+	out := new(ReadNotificationReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/ReadNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseappInterfaceClient) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadNotificationCountReply, error) {
+	out := new(GetUnreadNotificationCountReply)
+	err := c.cc.Invoke(ctx, "/api.baseapp.interface.v1.BaseappInterface/GetUnreadNotificationCount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +202,23 @@ type BaseappInterfaceServer interface {
 	SaveComment(context.Context, *SaveCommentRequest) (*SaveCommentReply, error)
 	GetCommentList(context.Context, *GetCommentListRequest) (*GetCommentListReply, error)
 	GetReplyList(context.Context, *GetReplyListRequest) (*GetCommentListReply, error)
+	// 当前用户发表的评论
+	ListMyComment(context.Context, *ListMyCommentRequest) (*GetCommentListReply, error)
 	GetComment(context.Context, *GetCommentRequest) (*GetCommentReply, error)
-	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error)
+	// 发表的评论由后台异步保存, 客户端据此确认评论是否保存成功, 只能查询自己发表的评论
+	// 保存失败的评论在等待保存的有效期内仍为等待保存, 过期后返回评论不存在
+	GetCommentStatus(context.Context, *GetCommentStatusRequest) (*GetCommentStatusReply, error)
+	ReactComment(context.Context, *ReactCommentRequest) (*ReactCommentReply, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error)
+	PinComment(context.Context, *PinCommentRequest) (*PinCommentReply, error)
+	UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentReply, error)
+	// 当前用户收到的通知
+	ListNotification(context.Context, *ListNotificationRequest) (*ListNotificationReply, error)
+	// 将通知标记为已读, ids 为空时全部标记为已读
+	ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationReply, error)
+	// 未读通知数, 用于显示角标
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
 	mustEmbedUnimplementedBaseappInterfaceServer()
 }
@@ -128,11 +239,38 @@ func (UnimplementedBaseappInterfaceServer) GetCommentList(context.Context, *GetC
 func (UnimplementedBaseappInterfaceServer) GetReplyList(context.Context, *GetReplyListRequest) (*GetCommentListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplyList not implemented")
 }
+func (UnimplementedBaseappInterfaceServer) ListMyComment(context.Context, *ListMyCommentRequest) (*GetCommentListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyComment not implemented")
+}
 func (UnimplementedBaseappInterfaceServer) GetComment(context.Context, *GetCommentRequest) (*GetCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
 }
-func (UnimplementedBaseappInterfaceServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+func (UnimplementedBaseappInterfaceServer) GetCommentStatus(context.Context, *GetCommentStatusRequest) (*GetCommentStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentStatus not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) ReactComment(context.Context, *ReactCommentRequest) (*ReactCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactComment not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) PinComment(context.Context, *PinCommentRequest) (*PinCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinComment not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinComment not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) ListNotification(context.Context, *ListNotificationRequest) (*ListNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotification not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadNotification not implemented")
+}
+func (UnimplementedBaseappInterfaceServer) GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
 }
 func (UnimplementedBaseappInterfaceServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
@@ -222,6 +360,24 @@ func _BaseappInterface_GetReplyList_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BaseappInterface_ListMyComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).ListMyComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/ListMyComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).ListMyComment(ctx, req.(*ListMyCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BaseappInterface_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommentRequest)
 	if err := dec(in); err != nil {
@@ -240,20 +396,164 @@ func _BaseappInterface_GetComment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BaseappInterface_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeCommentRequest)
+func _BaseappInterface_GetCommentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BaseappInterfaceServer).LikeComment(ctx, in)
+		return srv.(BaseappInterfaceServer).GetCommentStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/LikeComment",
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/GetCommentStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BaseappInterfaceServer).LikeComment(ctx, req.(*LikeCommentRequest))
+		return srv.(BaseappInterfaceServer).GetCommentStatus(ctx, req.(*GetCommentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_ReactComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).ReactComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/ReactComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).ReactComment(ctx, req.(*ReactCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/UpdateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_PinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).PinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/PinComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).PinComment(ctx, req.(*PinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_UnpinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).UnpinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/UnpinComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).UnpinComment(ctx, req.(*UnpinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_ListNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).ListNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/ListNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).ListNotification(ctx, req.(*ListNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).ReadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/ReadNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).ReadNotification(ctx, req.(*ReadNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseappInterface_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseappInterfaceServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.baseapp.interface.v1.BaseappInterface/GetUnreadNotificationCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseappInterfaceServer).GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -299,13 +599,49 @@ var BaseappInterface_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReplyList",
 			Handler:    _BaseappInterface_GetReplyList_Handler,
 		},
+		{
+			MethodName: "ListMyComment",
+			Handler:    _BaseappInterface_ListMyComment_Handler,
+		},
 		{
 			MethodName: "GetComment",
 			Handler:    _BaseappInterface_GetComment_Handler,
 		},
 		{
-			MethodName: "LikeComment",
-			Handler:    _BaseappInterface_LikeComment_Handler,
+			MethodName: "GetCommentStatus",
+			Handler:    _BaseappInterface_GetCommentStatus_Handler,
+		},
+		{
+			MethodName: "ReactComment",
+			Handler:    _BaseappInterface_ReactComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _BaseappInterface_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _BaseappInterface_DeleteComment_Handler,
+		},
+		{
+			MethodName: "PinComment",
+			Handler:    _BaseappInterface_PinComment_Handler,
+		},
+		{
+			MethodName: "UnpinComment",
+			Handler:    _BaseappInterface_UnpinComment_Handler,
+		},
+		{
+			MethodName: "ListNotification",
+			Handler:    _BaseappInterface_ListNotification_Handler,
+		},
+		{
+			MethodName: "ReadNotification",
+			Handler:    _BaseappInterface_ReadNotification_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _BaseappInterface_GetUnreadNotificationCount_Handler,
 		},
 		{
 			MethodName: "Login",
@@ -18,13 +18,22 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 type BaseappInterfaceHTTPServer interface {
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error)
 	GetComment(context.Context, *GetCommentRequest) (*GetCommentReply, error)
 	GetCommentList(context.Context, *GetCommentListRequest) (*GetCommentListReply, error)
+	GetCommentStatus(context.Context, *GetCommentStatusRequest) (*GetCommentStatusReply, error)
 	GetCommentSubject(context.Context, *GetCommentSubjectRequest) (*GetCommentSubjectReply, error)
 	GetReplyList(context.Context, *GetReplyListRequest) (*GetCommentListReply, error)
-	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error)
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountReply, error)
+	ListMyComment(context.Context, *ListMyCommentRequest) (*GetCommentListReply, error)
+	ListNotification(context.Context, *ListNotificationRequest) (*ListNotificationReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	PinComment(context.Context, *PinCommentRequest) (*PinCommentReply, error)
+	ReactComment(context.Context, *ReactCommentRequest) (*ReactCommentReply, error)
+	ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationReply, error)
 	SaveComment(context.Context, *SaveCommentRequest) (*SaveCommentReply, error)
+	UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentReply, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error)
 }
 
 func RegisterBaseappInterfaceHTTPServer(s *http.Server, srv BaseappInterfaceHTTPServer) {
@@ -33,8 +42,17 @@ func RegisterBaseappInterfaceHTTPServer(s *http.Server, srv BaseappInterfaceHTTP
 	r.POST("/api/comment", _BaseappInterface_SaveComment0_HTTP_Handler(srv))
 	r.GET("/api/comment/list", _BaseappInterface_GetCommentList0_HTTP_Handler(srv))
 	r.GET("/api/comment/reply", _BaseappInterface_GetReplyList0_HTTP_Handler(srv))
+	r.GET("/api/me/comments", _BaseappInterface_ListMyComment0_HTTP_Handler(srv))
 	r.GET("/api/comment/{id}", _BaseappInterface_GetComment0_HTTP_Handler(srv))
-	r.POST("/api/comment/like/{id}", _BaseappInterface_LikeComment0_HTTP_Handler(srv))
+	r.GET("/api/comment/{id}/status", _BaseappInterface_GetCommentStatus0_HTTP_Handler(srv))
+	r.POST("/api/comment/react/{id}", _BaseappInterface_ReactComment0_HTTP_Handler(srv))
+	r.PUT("/api/comment/{id}", _BaseappInterface_UpdateComment0_HTTP_Handler(srv))
+	r.DELETE("/api/comment/{id}", _BaseappInterface_DeleteComment0_HTTP_Handler(srv))
+	r.POST("/api/comment/pin/{id}", _BaseappInterface_PinComment0_HTTP_Handler(srv))
+	r.DELETE("/api/comment/pin/{id}", _BaseappInterface_UnpinComment0_HTTP_Handler(srv))
+	r.GET("/api/notification/list", _BaseappInterface_ListNotification0_HTTP_Handler(srv))
+	r.POST("/api/notification/read", _BaseappInterface_ReadNotification0_HTTP_Handler(srv))
+	r.GET("/api/notification/unread", _BaseappInterface_GetUnreadNotificationCount0_HTTP_Handler(srv))
 	r.POST("/api/account/login", _BaseappInterface_Login0_HTTP_Handler(srv))
 }
 
@@ -114,6 +132,25 @@ func _BaseappInterface_GetReplyList0_HTTP_Handler(srv BaseappInterfaceHTTPServer
 	}
 }
 
+func _BaseappInterface_ListMyComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/ListMyComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyComment(ctx, req.(*ListMyCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCommentListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _BaseappInterface_GetComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetCommentRequest
@@ -136,24 +173,191 @@ func _BaseappInterface_GetComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer)
 	}
 }
 
-func _BaseappInterface_LikeComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+func _BaseappInterface_GetCommentStatus0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LikeCommentRequest
+		var in GetCommentStatusRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/LikeComment")
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/GetCommentStatus")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LikeComment(ctx, req.(*LikeCommentRequest))
+			return srv.GetCommentStatus(ctx, req.(*GetCommentStatusRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*LikeCommentReply)
+		reply := out.(*GetCommentStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_ReactComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReactCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/ReactComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReactComment(ctx, req.(*ReactCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReactCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_UpdateComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/UpdateComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateComment(ctx, req.(*UpdateCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_DeleteComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/DeleteComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_PinComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PinCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/PinComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PinComment(ctx, req.(*PinCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PinCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_UnpinComment0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnpinCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/UnpinComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnpinComment(ctx, req.(*UnpinCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnpinCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_ListNotification0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListNotificationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/ListNotification")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNotification(ctx, req.(*ListNotificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListNotificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_ReadNotification0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReadNotificationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/ReadNotification")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReadNotification(ctx, req.(*ReadNotificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReadNotificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BaseappInterface_GetUnreadNotificationCount0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUnreadNotificationCountRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.baseapp.interface.v1.BaseappInterface/GetUnreadNotificationCount")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUnreadNotificationCountReply)
 		return ctx.Result(200, reply)
 	}
 }
@@ -178,13 +382,22 @@ func _BaseappInterface_Login0_HTTP_Handler(srv BaseappInterfaceHTTPServer) func(
 }
 
 type BaseappInterfaceHTTPClient interface {
+	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *DeleteCommentReply, err error)
 	GetComment(ctx context.Context, req *GetCommentRequest, opts ...http.CallOption) (rsp *GetCommentReply, err error)
 	GetCommentList(ctx context.Context, req *GetCommentListRequest, opts ...http.CallOption) (rsp *GetCommentListReply, err error)
+	GetCommentStatus(ctx context.Context, req *GetCommentStatusRequest, opts ...http.CallOption) (rsp *GetCommentStatusReply, err error)
 	GetCommentSubject(ctx context.Context, req *GetCommentSubjectRequest, opts ...http.CallOption) (rsp *GetCommentSubjectReply, err error)
 	GetReplyList(ctx context.Context, req *GetReplyListRequest, opts ...http.CallOption) (rsp *GetCommentListReply, err error)
-	LikeComment(ctx context.Context, req *LikeCommentRequest, opts ...http.CallOption) (rsp *LikeCommentReply, err error)
+	GetUnreadNotificationCount(ctx context.Context, req *GetUnreadNotificationCountRequest, opts ...http.CallOption) (rsp *GetUnreadNotificationCountReply, err error)
+	ListMyComment(ctx context.Context, req *ListMyCommentRequest, opts ...http.CallOption) (rsp *GetCommentListReply, err error)
+	ListNotification(ctx context.Context, req *ListNotificationRequest, opts ...http.CallOption) (rsp *ListNotificationReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	PinComment(ctx context.Context, req *PinCommentRequest, opts ...http.CallOption) (rsp *PinCommentReply, err error)
+	ReactComment(ctx context.Context, req *ReactCommentRequest, opts ...http.CallOption) (rsp *ReactCommentReply, err error)
+	ReadNotification(ctx context.Context, req *ReadNotificationRequest, opts ...http.CallOption) (rsp *ReadNotificationReply, err error)
 	SaveComment(ctx context.Context, req *SaveCommentRequest, opts ...http.CallOption) (rsp *SaveCommentReply, err error)
+	UnpinComment(ctx context.Context, req *UnpinCommentRequest, opts ...http.CallOption) (rsp *UnpinCommentReply, err error)
+	UpdateComment(ctx context.Context, req *UpdateCommentRequest, opts ...http.CallOption) (rsp *UpdateCommentReply, err error)
 }
 
 type BaseappInterfaceHTTPClientImpl struct {
@@ -195,6 +408,19 @@ func NewBaseappInterfaceHTTPClient(client *http.Client) BaseappInterfaceHTTPClie
 	return &BaseappInterfaceHTTPClientImpl{client}
 }
 
+func (c *BaseappInterfaceHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...http.CallOption) (*DeleteCommentReply, error) {
+	var out DeleteCommentReply
+	pattern := "/api/comment/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/DeleteComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BaseappInterfaceHTTPClientImpl) GetComment(ctx context.Context, in *GetCommentRequest, opts ...http.CallOption) (*GetCommentReply, error) {
 	var out GetCommentReply
 	pattern := "/api/comment/{id}"
@@ -221,6 +447,19 @@ func (c *BaseappInterfaceHTTPClientImpl) GetCommentList(ctx context.Context, in
 	return &out, err
 }
 
+func (c *BaseappInterfaceHTTPClientImpl) GetCommentStatus(ctx context.Context, in *GetCommentStatusRequest, opts ...http.CallOption) (*GetCommentStatusReply, error) {
+	var out GetCommentStatusReply
+	pattern := "/api/comment/{id}/status"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/GetCommentStatus"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BaseappInterfaceHTTPClientImpl) GetCommentSubject(ctx context.Context, in *GetCommentSubjectRequest, opts ...http.CallOption) (*GetCommentSubjectReply, error) {
 	var out GetCommentSubjectReply
 	pattern := "/api/comment/subject"
@@ -247,13 +486,39 @@ func (c *BaseappInterfaceHTTPClientImpl) GetReplyList(ctx context.Context, in *G
 	return &out, err
 }
 
-func (c *BaseappInterfaceHTTPClientImpl) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...http.CallOption) (*LikeCommentReply, error) {
-	var out LikeCommentReply
-	pattern := "/api/comment/like/{id}"
+func (c *BaseappInterfaceHTTPClientImpl) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...http.CallOption) (*GetUnreadNotificationCountReply, error) {
+	var out GetUnreadNotificationCountReply
+	pattern := "/api/notification/unread"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/LikeComment"))
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/GetUnreadNotificationCount"))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BaseappInterfaceHTTPClientImpl) ListMyComment(ctx context.Context, in *ListMyCommentRequest, opts ...http.CallOption) (*GetCommentListReply, error) {
+	var out GetCommentListReply
+	pattern := "/api/me/comments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/ListMyComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BaseappInterfaceHTTPClientImpl) ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...http.CallOption) (*ListNotificationReply, error) {
+	var out ListNotificationReply
+	pattern := "/api/notification/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/ListNotification"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -273,6 +538,45 @@ func (c *BaseappInterfaceHTTPClientImpl) Login(ctx context.Context, in *LoginReq
 	return &out, err
 }
 
+func (c *BaseappInterfaceHTTPClientImpl) PinComment(ctx context.Context, in *PinCommentRequest, opts ...http.CallOption) (*PinCommentReply, error) {
+	var out PinCommentReply
+	pattern := "/api/comment/pin/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/PinComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BaseappInterfaceHTTPClientImpl) ReactComment(ctx context.Context, in *ReactCommentRequest, opts ...http.CallOption) (*ReactCommentReply, error) {
+	var out ReactCommentReply
+	pattern := "/api/comment/react/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/ReactComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BaseappInterfaceHTTPClientImpl) ReadNotification(ctx context.Context, in *ReadNotificationRequest, opts ...http.CallOption) (*ReadNotificationReply, error) {
+	var out ReadNotificationReply
+	pattern := "/api/notification/read"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/ReadNotification"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BaseappInterfaceHTTPClientImpl) SaveComment(ctx context.Context, in *SaveCommentRequest, opts ...http.CallOption) (*SaveCommentReply, error) {
 	var out SaveCommentReply
 	pattern := "/api/comment"
@@ -285,3 +589,29 @@ func (c *BaseappInterfaceHTTPClientImpl) SaveComment(ctx context.Context, in *Sa
 	}
 	return &out, err
 }
+
+func (c *BaseappInterfaceHTTPClientImpl) UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...http.CallOption) (*UnpinCommentReply, error) {
+	var out UnpinCommentReply
+	pattern := "/api/comment/pin/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/UnpinComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BaseappInterfaceHTTPClientImpl) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...http.CallOption) (*UpdateCommentReply, error) {
+	var out UpdateCommentReply
+	pattern := "/api/comment/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.baseapp.interface.v1.BaseappInterface/UpdateComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -3,7 +3,10 @@
 package v1
 
 import (
+	context "context"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -15,6 +18,14 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CommentJobClient interface {
+	// 查询重试后仍处理失败的消息
+	ListDeadLetter(ctx context.Context, in *ListDeadLetterRequest, opts ...grpc.CallOption) (*ListDeadLetterReply, error)
+	// 将死信重新发送到原主题, 只处理待处理状态的死信
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterReply, error)
+	// 丢弃死信, 只处理待处理状态的死信
+	DiscardDeadLetter(ctx context.Context, in *DiscardDeadLetterRequest, opts ...grpc.CallOption) (*DiscardDeadLetterReply, error)
+	// 按 comment_index 与 comment_reaction 重新计算一个主题的计数, 返回与存储不一致的计数
+	ReconcileCounter(ctx context.Context, in *ReconcileCounterRequest, opts ...grpc.CallOption) (*ReconcileCounterReply, error)
 }
 
 type commentJobClient struct {
@@ -25,10 +36,54 @@ func NewCommentJobClient(cc grpc.ClientConnInterface) CommentJobClient {
 	return &commentJobClient{cc}
 }
 
+func (c *commentJobClient) ListDeadLetter(ctx context.Context, in *ListDeadLetterRequest, opts ...grpc.CallOption) (*ListDeadLetterReply, error) {
+	out := new(ListDeadLetterReply)
+	err := c.cc.Invoke(ctx, "/comment.job.v1.CommentJob/ListDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentJobClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterReply, error) {
+	out := new(ReplayDeadLetterReply)
+	err := c.cc.Invoke(ctx, "/comment.job.v1.CommentJob/ReplayDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentJobClient) DiscardDeadLetter(ctx context.Context, in *DiscardDeadLetterRequest, opts ...grpc.CallOption) (*DiscardDeadLetterReply, error) {
+	out := new(DiscardDeadLetterReply)
+	err := c.cc.Invoke(ctx, "/comment.job.v1.CommentJob/DiscardDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentJobClient) ReconcileCounter(ctx context.Context, in *ReconcileCounterRequest, opts ...grpc.CallOption) (*ReconcileCounterReply, error) {
+	out := new(ReconcileCounterReply)
+	err := c.cc.Invoke(ctx, "/comment.job.v1.CommentJob/ReconcileCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentJobServer is the server API for CommentJob service.
 // All implementations must embed UnimplementedCommentJobServer
 // for forward compatibility
 type CommentJobServer interface {
+	// 查询重试后仍处理失败的消息
+	ListDeadLetter(context.Context, *ListDeadLetterRequest) (*ListDeadLetterReply, error)
+	// 将死信重新发送到原主题, 只处理待处理状态的死信
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterReply, error)
+	// 丢弃死信, 只处理待处理状态的死信
+	DiscardDeadLetter(context.Context, *DiscardDeadLetterRequest) (*DiscardDeadLetterReply, error)
+	// 按 comment_index 与 comment_reaction 重新计算一个主题的计数, 返回与存储不一致的计数
+	ReconcileCounter(context.Context, *ReconcileCounterRequest) (*ReconcileCounterReply, error)
 	mustEmbedUnimplementedCommentJobServer()
 }
 
@@ -36,6 +91,18 @@ type CommentJobServer interface {
 type UnimplementedCommentJobServer struct {
 }
 
+func (UnimplementedCommentJobServer) ListDeadLetter(context.Context, *ListDeadLetterRequest) (*ListDeadLetterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetter not implemented")
+}
+func (UnimplementedCommentJobServer) ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetter not implemented")
+}
+func (UnimplementedCommentJobServer) DiscardDeadLetter(context.Context, *DiscardDeadLetterRequest) (*DiscardDeadLetterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardDeadLetter not implemented")
+}
+func (UnimplementedCommentJobServer) ReconcileCounter(context.Context, *ReconcileCounterRequest) (*ReconcileCounterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileCounter not implemented")
+}
 func (UnimplementedCommentJobServer) mustEmbedUnimplementedCommentJobServer() {}
 
 // UnsafeCommentJobServer may be embedded to opt out of forward compatibility for this service.
@@ -49,13 +116,102 @@ func RegisterCommentJobServer(s grpc.ServiceRegistrar, srv CommentJobServer) {
 	s.RegisterService(&CommentJob_ServiceDesc, srv)
 }
 
+func _CommentJob_ListDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentJobServer).ListDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.job.v1.CommentJob/ListDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentJobServer).ListDeadLetter(ctx, req.(*ListDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentJob_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentJobServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.job.v1.CommentJob/ReplayDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentJobServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentJob_DiscardDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentJobServer).DiscardDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.job.v1.CommentJob/DiscardDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentJobServer).DiscardDeadLetter(ctx, req.(*DiscardDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentJob_ReconcileCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentJobServer).ReconcileCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.job.v1.CommentJob/ReconcileCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentJobServer).ReconcileCounter(ctx, req.(*ReconcileCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentJob_ServiceDesc is the grpc.ServiceDesc for CommentJob service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var CommentJob_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "comment.job.v1.CommentJob",
 	HandlerType: (*CommentJobServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "api/comment/job/v1/comment_job.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeadLetter",
+			Handler:    _CommentJob_ListDeadLetter_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _CommentJob_ReplayDeadLetter_Handler,
+		},
+		{
+			MethodName: "DiscardDeadLetter",
+			Handler:    _CommentJob_DiscardDeadLetter_Handler,
+		},
+		{
+			MethodName: "ReconcileCounter",
+			Handler:    _CommentJob_ReconcileCounter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/comment/job/v1/comment_job.proto",
 }
@@ -24,16 +24,66 @@ const (
 type CommentServiceErrorReason int32
 
 const (
-	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_UNKNOWN_ERROR CommentServiceErrorReason = 0
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_UNKNOWN_ERROR           CommentServiceErrorReason = 0
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_COMMENT_NOT_FOUND       CommentServiceErrorReason = 1
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_PERMISSION_DENIED       CommentServiceErrorReason = 2
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_STATE           CommentServiceErrorReason = 3
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_EDIT_WINDOW_EXPIRED     CommentServiceErrorReason = 4
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_CURSOR          CommentServiceErrorReason = 5
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_PIN_LIMIT_EXCEEDED      CommentServiceErrorReason = 6
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_REACTION        CommentServiceErrorReason = 7
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_SUBJECT_CLOSED          CommentServiceErrorReason = 8
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_RATE_LIMITED            CommentServiceErrorReason = 9
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_IDEMPOTENCY_KEY CommentServiceErrorReason = 10
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_SUBJECT_NOT_FOUND       CommentServiceErrorReason = 11
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_SUBJECT         CommentServiceErrorReason = 12
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_MESSAGE_TOO_LONG        CommentServiceErrorReason = 13
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_REPLY_NOT_ALLOWED       CommentServiceErrorReason = 14
+	// 请求id已被内容不同的请求使用
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_IDEMPOTENCY_KEY_REUSED CommentServiceErrorReason = 15
+	// 相同请求id的请求正在处理, 客户端稍后重试
+	CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_REQUEST_IN_PROGRESS CommentServiceErrorReason = 16
 )
 
 // Enum value maps for CommentServiceErrorReason.
 var (
 	CommentServiceErrorReason_name = map[int32]string{
-		0: "COMMENT_SERVICE_ERROR_REASON_UNKNOWN_ERROR",
+		0:  "COMMENT_SERVICE_ERROR_REASON_UNKNOWN_ERROR",
+		1:  "COMMENT_SERVICE_ERROR_REASON_COMMENT_NOT_FOUND",
+		2:  "COMMENT_SERVICE_ERROR_REASON_PERMISSION_DENIED",
+		3:  "COMMENT_SERVICE_ERROR_REASON_INVALID_STATE",
+		4:  "COMMENT_SERVICE_ERROR_REASON_EDIT_WINDOW_EXPIRED",
+		5:  "COMMENT_SERVICE_ERROR_REASON_INVALID_CURSOR",
+		6:  "COMMENT_SERVICE_ERROR_REASON_PIN_LIMIT_EXCEEDED",
+		7:  "COMMENT_SERVICE_ERROR_REASON_INVALID_REACTION",
+		8:  "COMMENT_SERVICE_ERROR_REASON_SUBJECT_CLOSED",
+		9:  "COMMENT_SERVICE_ERROR_REASON_RATE_LIMITED",
+		10: "COMMENT_SERVICE_ERROR_REASON_INVALID_IDEMPOTENCY_KEY",
+		11: "COMMENT_SERVICE_ERROR_REASON_SUBJECT_NOT_FOUND",
+		12: "COMMENT_SERVICE_ERROR_REASON_INVALID_SUBJECT",
+		13: "COMMENT_SERVICE_ERROR_REASON_MESSAGE_TOO_LONG",
+		14: "COMMENT_SERVICE_ERROR_REASON_REPLY_NOT_ALLOWED",
+		15: "COMMENT_SERVICE_ERROR_REASON_IDEMPOTENCY_KEY_REUSED",
+		16: "COMMENT_SERVICE_ERROR_REASON_REQUEST_IN_PROGRESS",
 	}
 	CommentServiceErrorReason_value = map[string]int32{
-		"COMMENT_SERVICE_ERROR_REASON_UNKNOWN_ERROR": 0,
+		"COMMENT_SERVICE_ERROR_REASON_UNKNOWN_ERROR":           0,
+		"COMMENT_SERVICE_ERROR_REASON_COMMENT_NOT_FOUND":       1,
+		"COMMENT_SERVICE_ERROR_REASON_PERMISSION_DENIED":       2,
+		"COMMENT_SERVICE_ERROR_REASON_INVALID_STATE":           3,
+		"COMMENT_SERVICE_ERROR_REASON_EDIT_WINDOW_EXPIRED":     4,
+		"COMMENT_SERVICE_ERROR_REASON_INVALID_CURSOR":          5,
+		"COMMENT_SERVICE_ERROR_REASON_PIN_LIMIT_EXCEEDED":      6,
+		"COMMENT_SERVICE_ERROR_REASON_INVALID_REACTION":        7,
+		"COMMENT_SERVICE_ERROR_REASON_SUBJECT_CLOSED":          8,
+		"COMMENT_SERVICE_ERROR_REASON_RATE_LIMITED":            9,
+		"COMMENT_SERVICE_ERROR_REASON_INVALID_IDEMPOTENCY_KEY": 10,
+		"COMMENT_SERVICE_ERROR_REASON_SUBJECT_NOT_FOUND":       11,
+		"COMMENT_SERVICE_ERROR_REASON_INVALID_SUBJECT":         12,
+		"COMMENT_SERVICE_ERROR_REASON_MESSAGE_TOO_LONG":        13,
+		"COMMENT_SERVICE_ERROR_REASON_REPLY_NOT_ALLOWED":       14,
+		"COMMENT_SERVICE_ERROR_REASON_IDEMPOTENCY_KEY_REUSED":  15,
+		"COMMENT_SERVICE_ERROR_REASON_REQUEST_IN_PROGRESS":     16,
 	}
 )
 
@@ -72,15 +122,73 @@ var file_api_comment_service_v1_comment_error_proto_rawDesc = []byte{
 	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x63, 0x6f,
 	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
 	0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x51, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xee, 0x07, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x00, 0x12, 0x38, 0x0a, 0x2e, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x38, 0x0a,
+	0x2e, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x45,
+	0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10,
+	0x02, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x34, 0x0a, 0x2a, 0x43, 0x4f, 0x4d, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x3a, 0x0a,
+	0x30, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x44,
+	0x49, 0x54, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x35, 0x0a, 0x2b, 0x43, 0x4f, 0x4d,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x12, 0x39, 0x0a, 0x2f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x50, 0x49, 0x4e, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x37, 0x0a, 0x2d, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x1a, 0x04,
+	0xa8, 0x45, 0x90, 0x03, 0x12, 0x35, 0x0a, 0x2b, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x33, 0x0a, 0x29, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03,
+	0x12, 0x3e, 0x0a, 0x34, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x12, 0x38, 0x0a, 0x2e, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x0b, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x36, 0x0a, 0x2c, 0x43, 0x4f,
+	0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0c, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x37, 0x0a, 0x2d, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45,
 	0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x10, 0x00, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x2a, 0x50, 0x01, 0x5a, 0x26, 0x62, 0x61,
-	0x73, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c,
+	0x4f, 0x4e, 0x47, 0x10, 0x0d, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x38, 0x0a, 0x2e, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x50, 0x4c,
+	0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x0e, 0x1a,
+	0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x3d, 0x0a, 0x33, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43,
+	0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x45, 0x55, 0x53, 0x45, 0x44, 0x10, 0x0f, 0x1a, 0x04,
+	0xa8, 0x45, 0x99, 0x03, 0x12, 0x3a, 0x0a, 0x30, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4e, 0x5f,
+	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x10, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03,
+	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x2a, 0x50, 0x01, 0x5a, 0x26, 0x62, 0x61, 0x73, 0x65,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,3 +19,147 @@ func IsCommentServiceErrorReasonUnknownError(err error) bool {
 func ErrorCommentServiceErrorReasonUnknownError(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_UNKNOWN_ERROR.String(), fmt.Sprintf(format, args...))
 }
+
+func IsCommentServiceErrorReasonCommentNotFound(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_COMMENT_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorCommentServiceErrorReasonCommentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_COMMENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonPermissionDenied(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_PERMISSION_DENIED.String() && e.Code == 403
+}
+
+func ErrorCommentServiceErrorReasonPermissionDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_PERMISSION_DENIED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonInvalidState(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_STATE.String() && e.Code == 400
+}
+
+func ErrorCommentServiceErrorReasonInvalidState(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_STATE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonEditWindowExpired(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_EDIT_WINDOW_EXPIRED.String() && e.Code == 403
+}
+
+func ErrorCommentServiceErrorReasonEditWindowExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_EDIT_WINDOW_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonInvalidCursor(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_CURSOR.String() && e.Code == 400
+}
+
+func ErrorCommentServiceErrorReasonInvalidCursor(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_CURSOR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonPinLimitExceeded(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_PIN_LIMIT_EXCEEDED.String() && e.Code == 400
+}
+
+func ErrorCommentServiceErrorReasonPinLimitExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_PIN_LIMIT_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonInvalidReaction(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_REACTION.String() && e.Code == 400
+}
+
+func ErrorCommentServiceErrorReasonInvalidReaction(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_REACTION.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonSubjectClosed(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_SUBJECT_CLOSED.String() && e.Code == 403
+}
+
+func ErrorCommentServiceErrorReasonSubjectClosed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_SUBJECT_CLOSED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonRateLimited(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_RATE_LIMITED.String() && e.Code == 429
+}
+
+func ErrorCommentServiceErrorReasonRateLimited(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_RATE_LIMITED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonInvalidIdempotencyKey(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_IDEMPOTENCY_KEY.String() && e.Code == 400
+}
+
+func ErrorCommentServiceErrorReasonInvalidIdempotencyKey(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_IDEMPOTENCY_KEY.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonSubjectNotFound(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_SUBJECT_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorCommentServiceErrorReasonSubjectNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_SUBJECT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonInvalidSubject(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_SUBJECT.String() && e.Code == 400
+}
+
+func ErrorCommentServiceErrorReasonInvalidSubject(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_INVALID_SUBJECT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonMessageTooLong(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_MESSAGE_TOO_LONG.String() && e.Code == 400
+}
+
+func ErrorCommentServiceErrorReasonMessageTooLong(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_MESSAGE_TOO_LONG.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonReplyNotAllowed(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_REPLY_NOT_ALLOWED.String() && e.Code == 403
+}
+
+func ErrorCommentServiceErrorReasonReplyNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_REPLY_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonIdempotencyKeyReused(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_IDEMPOTENCY_KEY_REUSED.String() && e.Code == 409
+}
+
+func ErrorCommentServiceErrorReasonIdempotencyKeyReused(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_IDEMPOTENCY_KEY_REUSED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentServiceErrorReasonRequestInProgress(err error) bool {
+	e := errors.FromError(err)
+	return e.Reason == CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_REQUEST_IN_PROGRESS.String() && e.Code == 409
+}
+
+func ErrorCommentServiceErrorReasonRequestInProgress(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, CommentServiceErrorReason_COMMENT_SERVICE_ERROR_REASON_REQUEST_IN_PROGRESS.String(), fmt.Sprintf(format, args...))
+}
@@ -19,14 +19,48 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CommentClient interface {
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentReply, error)
-	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentReply, error)
+	// 对评论表态, 每个成员对一条评论只保留一个表态, reaction 为空时取消
+	ReactComment(ctx context.Context, in *ReactCommentRequest, opts ...grpc.CallOption) (*ReactCommentReply, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentReply, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentReply, error)
 	ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentReply, error)
 	ListSubComment(ctx context.Context, in *ListSubCommentRequest, opts ...grpc.CallOption) (*ListCommentReply, error)
+	// 查询成员发表的评论, 跨所有主题按发表时间倒序
+	ListCommentsByMember(ctx context.Context, in *ListCommentsByMemberRequest, opts ...grpc.CallOption) (*ListCommentReply, error)
+	// 查询评论主题, 主题不存在时返回 id 为 0 的开放主题, 不会创建主题
 	GetCommentSubject(ctx context.Context, in *GetCommentSubjectRequest, opts ...grpc.CallOption) (*GetCommentSubjectReply, error)
+	// 登记评论主题及其所有者, 主题已存在且没有所有者时设置所有者, 已有其他所有者时返回 PERMISSION_DENIED
+	// 未登记的主题在发表第一条评论时创建, 没有所有者; 重复登记时以最近一次的标题、链接与扩展属性为准
+	RegisterSubject(ctx context.Context, in *RegisterSubjectRequest, opts ...grpc.CallOption) (*RegisterSubjectReply, error)
+	// 更新评论主题的标题、链接与扩展属性, 非管理员只允许主题所有者操作, 只有管理员可以转移所有者
+	UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpc.CallOption) (*UpdateSubjectReply, error)
+	// 查询 obj_type 的评论策略, 策略随评论服务的配置重新加载
+	GetCommentPolicy(ctx context.Context, in *GetCommentPolicyRequest, opts ...grpc.CallOption) (*GetCommentPolicyReply, error)
 	ListCommentSubject(ctx context.Context, in *ListCommentSubjectRequest, opts ...grpc.CallOption) (*ListCommentSubjectReply, error)
-	GetCommentLiked(ctx context.Context, in *GetCommentLikedRequest, opts ...grpc.CallOption) (*GetCommentLikedReply, error)
+	// 批量查询成员对评论的表态
+	ListMemberReaction(ctx context.Context, in *ListMemberReactionRequest, opts ...grpc.CallOption) (*ListMemberReactionReply, error)
 	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentReply, error)
+	// 查询评论的保存状态, 发表后评论由 job 异步保存
+	GetCommentStatus(ctx context.Context, in *GetCommentStatusRequest, opts ...grpc.CallOption) (*GetCommentStatusReply, error)
+	// 查询待审核的评论
+	ListPendingComment(ctx context.Context, in *ListPendingCommentRequest, opts ...grpc.CallOption) (*ListCommentReply, error)
+	// 批量审核评论, 将评论流转到目标状态
+	ReviewComment(ctx context.Context, in *ReviewCommentRequest, opts ...grpc.CallOption) (*ReviewCommentReply, error)
+	// 置顶根评论, 置顶的评论在列表第一页之前返回
+	PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentReply, error)
+	UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...grpc.CallOption) (*UnpinCommentReply, error)
+	// 开放主题的评论区, 锁定的主题只能由管理员开放
+	OpenSubject(ctx context.Context, in *OpenSubjectRequest, opts ...grpc.CallOption) (*OpenSubjectReply, error)
+	// 关闭主题的评论区, 关闭后不能发表新评论
+	CloseSubject(ctx context.Context, in *CloseSubjectRequest, opts ...grpc.CallOption) (*CloseSubjectReply, error)
+	// 锁定主题的评论区, 锁定后评论区只读, 仅管理员可操作
+	LockSubject(ctx context.Context, in *LockSubjectRequest, opts ...grpc.CallOption) (*LockSubjectReply, error)
+	// 查询成员收到的通知, 按最近更新时间倒序
+	ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (*ListNotificationReply, error)
+	// 将通知标记为已读, ids 为空时全部标记为已读
+	ReadNotification(ctx context.Context, in *ReadNotificationRequest, opts ...grpc.CallOption) (*ReadNotificationReply, error)
+	// 查询成员未读的通知数
+	GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadNotificationCountReply, error)
 }
 
 type commentClient struct {
@@ -46,9 +80,18 @@ func (c *commentClient) CreateComment(ctx context.Context, in *CreateCommentRequ
 	return out, nil
 }
 
-func (c *commentClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentReply, error) {
-	out := new(LikeCommentReply)
-	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/LikeComment", in, out, opts...)
+func (c *commentClient) ReactComment(ctx context.Context, in *ReactCommentRequest, opts ...grpc.CallOption) (*ReactCommentReply, error) {
+	out := new(ReactCommentReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ReactComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentReply, error) {
+	out := new(UpdateCommentReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/UpdateComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +125,15 @@ func (c *commentClient) ListSubComment(ctx context.Context, in *ListSubCommentRe
 	return out, nil
 }
 
+func (c *commentClient) ListCommentsByMember(ctx context.Context, in *ListCommentsByMemberRequest, opts ...grpc.CallOption) (*ListCommentReply, error) {
+	out := new(ListCommentReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ListCommentsByMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentClient) GetCommentSubject(ctx context.Context, in *GetCommentSubjectRequest, opts ...grpc.CallOption) (*GetCommentSubjectReply, error) {
 	out := new(GetCommentSubjectReply)
 	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/GetCommentSubject", in, out, opts...)
@@ -91,6 +143,33 @@ func (c *commentClient) GetCommentSubject(ctx context.Context, in *GetCommentSub
 	return out, nil
 }
 
+func (c *commentClient) RegisterSubject(ctx context.Context, in *RegisterSubjectRequest, opts ...grpc.CallOption) (*RegisterSubjectReply, error) {
+	out := new(RegisterSubjectReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/RegisterSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpc.CallOption) (*UpdateSubjectReply, error) {
+	out := new(UpdateSubjectReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/UpdateSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) GetCommentPolicy(ctx context.Context, in *GetCommentPolicyRequest, opts ...grpc.CallOption) (*GetCommentPolicyReply, error) {
+	out := new(GetCommentPolicyReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/GetCommentPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentClient) ListCommentSubject(ctx context.Context, in *ListCommentSubjectRequest, opts ...grpc.CallOption) (*ListCommentSubjectReply, error) {
 	out := new(ListCommentSubjectReply)
 	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ListCommentSubject", in, out, opts...)
@@ -100,9 +179,9 @@ func (c *commentClient) ListCommentSubject(ctx context.Context, in *ListCommentS
 	return out, nil
 }
 
-func (c *commentClient) GetCommentLiked(ctx context.Context, in *GetCommentLikedRequest, opts ...grpc.CallOption) (*GetCommentLikedReply, error) {
-	out := new(GetCommentLikedReply)
-	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/GetCommentLiked", in, out, opts...)
+func (c *commentClient) ListMemberReaction(ctx context.Context, in *ListMemberReactionRequest, opts ...grpc.CallOption) (*ListMemberReactionReply, error) {
+	out := new(ListMemberReactionReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ListMemberReaction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,19 +197,152 @@ func (c *commentClient) GetComment(ctx context.Context, in *GetCommentRequest, o
 	return out, nil
 }
 
+func (c *commentClient) GetCommentStatus(ctx context.Context, in *GetCommentStatusRequest, opts ...grpc.CallOption) (*GetCommentStatusReply, error) {
+	out := new(GetCommentStatusReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/GetCommentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ListPendingComment(ctx context.Context, in *ListPendingCommentRequest, opts ...grpc.CallOption) (*ListCommentReply, error) {
+	out := new(ListCommentReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ListPendingComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ReviewComment(ctx context.Context, in *ReviewCommentRequest, opts ...grpc.CallOption) (*ReviewCommentReply, error) {
+	out := new(ReviewCommentReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ReviewComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentReply, error) {
+	out := new(PinCommentReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/PinComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...grpc.CallOption) (*UnpinCommentReply, error) {
+	out := new(UnpinCommentReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/UnpinComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) OpenSubject(ctx context.Context, in *OpenSubjectRequest, opts ...grpc.CallOption) (*OpenSubjectReply, error) {
+	out := new(OpenSubjectReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/OpenSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) CloseSubject(ctx context.Context, in *CloseSubjectRequest, opts ...grpc.CallOption) (*CloseSubjectReply, error) {
+	out := new(CloseSubjectReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/CloseSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) LockSubject(ctx context.Context, in *LockSubjectRequest, opts ...grpc.CallOption) (*LockSubjectReply, error) {
+	out := new(LockSubjectReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/LockSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (*ListNotificationReply, error) {
+	out := new(ListNotificationReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ListNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ReadNotification(ctx context.Context, in *ReadNotificationRequest, opts ...grpc.CallOption) (*ReadNotificationReply, error) {
+	out := new(ReadNotificationReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/ReadNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadNotificationCountReply, error) {
+	out := new(GetUnreadNotificationCountReply)
+	err := c.cc.Invoke(ctx, "/comment.service.v1.Comment/GetUnreadNotificationCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServer is the server API for Comment service.
 // All implementations must embed UnimplementedCommentServer
 // for forward compatibility
 type CommentServer interface {
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentReply, error)
-	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error)
+	// 对评论表态, 每个成员对一条评论只保留一个表态, reaction 为空时取消
+	ReactComment(context.Context, *ReactCommentRequest) (*ReactCommentReply, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error)
 	ListComment(context.Context, *ListCommentRequest) (*ListCommentReply, error)
 	ListSubComment(context.Context, *ListSubCommentRequest) (*ListCommentReply, error)
+	// 查询成员发表的评论, 跨所有主题按发表时间倒序
+	ListCommentsByMember(context.Context, *ListCommentsByMemberRequest) (*ListCommentReply, error)
+	// 查询评论主题, 主题不存在时返回 id 为 0 的开放主题, 不会创建主题
 	GetCommentSubject(context.Context, *GetCommentSubjectRequest) (*GetCommentSubjectReply, error)
+	// 登记评论主题及其所有者, 主题已存在且没有所有者时设置所有者, 已有其他所有者时返回 PERMISSION_DENIED
+	// 未登记的主题在发表第一条评论时创建, 没有所有者; 重复登记时以最近一次的标题、链接与扩展属性为准
+	RegisterSubject(context.Context, *RegisterSubjectRequest) (*RegisterSubjectReply, error)
+	// 更新评论主题的标题、链接与扩展属性, 非管理员只允许主题所有者操作, 只有管理员可以转移所有者
+	UpdateSubject(context.Context, *UpdateSubjectRequest) (*UpdateSubjectReply, error)
+	// 查询 obj_type 的评论策略, 策略随评论服务的配置重新加载
+	GetCommentPolicy(context.Context, *GetCommentPolicyRequest) (*GetCommentPolicyReply, error)
 	ListCommentSubject(context.Context, *ListCommentSubjectRequest) (*ListCommentSubjectReply, error)
-	GetCommentLiked(context.Context, *GetCommentLikedRequest) (*GetCommentLikedReply, error)
+	// 批量查询成员对评论的表态
+	ListMemberReaction(context.Context, *ListMemberReactionRequest) (*ListMemberReactionReply, error)
 	GetComment(context.Context, *GetCommentRequest) (*GetCommentReply, error)
+	// 查询评论的保存状态, 发表后评论由 job 异步保存
+	GetCommentStatus(context.Context, *GetCommentStatusRequest) (*GetCommentStatusReply, error)
+	// 查询待审核的评论
+	ListPendingComment(context.Context, *ListPendingCommentRequest) (*ListCommentReply, error)
+	// 批量审核评论, 将评论流转到目标状态
+	ReviewComment(context.Context, *ReviewCommentRequest) (*ReviewCommentReply, error)
+	// 置顶根评论, 置顶的评论在列表第一页之前返回
+	PinComment(context.Context, *PinCommentRequest) (*PinCommentReply, error)
+	UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentReply, error)
+	// 开放主题的评论区, 锁定的主题只能由管理员开放
+	OpenSubject(context.Context, *OpenSubjectRequest) (*OpenSubjectReply, error)
+	// 关闭主题的评论区, 关闭后不能发表新评论
+	CloseSubject(context.Context, *CloseSubjectRequest) (*CloseSubjectReply, error)
+	// 锁定主题的评论区, 锁定后评论区只读, 仅管理员可操作
+	LockSubject(context.Context, *LockSubjectRequest) (*LockSubjectReply, error)
+	// 查询成员收到的通知, 按最近更新时间倒序
+	ListNotification(context.Context, *ListNotificationRequest) (*ListNotificationReply, error)
+	// 将通知标记为已读, ids 为空时全部标记为已读
+	ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationReply, error)
+	// 查询成员未读的通知数
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountReply, error)
 	mustEmbedUnimplementedCommentServer()
 }
 
@@ -141,8 +353,11 @@ type UnimplementedCommentServer struct {
 func (UnimplementedCommentServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
-func (UnimplementedCommentServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+func (UnimplementedCommentServer) ReactComment(context.Context, *ReactCommentRequest) (*ReactCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactComment not implemented")
+}
+func (UnimplementedCommentServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
 }
 func (UnimplementedCommentServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
@@ -153,18 +368,63 @@ func (UnimplementedCommentServer) ListComment(context.Context, *ListCommentReque
 func (UnimplementedCommentServer) ListSubComment(context.Context, *ListSubCommentRequest) (*ListCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSubComment not implemented")
 }
+func (UnimplementedCommentServer) ListCommentsByMember(context.Context, *ListCommentsByMemberRequest) (*ListCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentsByMember not implemented")
+}
 func (UnimplementedCommentServer) GetCommentSubject(context.Context, *GetCommentSubjectRequest) (*GetCommentSubjectReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentSubject not implemented")
 }
+func (UnimplementedCommentServer) RegisterSubject(context.Context, *RegisterSubjectRequest) (*RegisterSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSubject not implemented")
+}
+func (UnimplementedCommentServer) UpdateSubject(context.Context, *UpdateSubjectRequest) (*UpdateSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubject not implemented")
+}
+func (UnimplementedCommentServer) GetCommentPolicy(context.Context, *GetCommentPolicyRequest) (*GetCommentPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentPolicy not implemented")
+}
 func (UnimplementedCommentServer) ListCommentSubject(context.Context, *ListCommentSubjectRequest) (*ListCommentSubjectReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCommentSubject not implemented")
 }
-func (UnimplementedCommentServer) GetCommentLiked(context.Context, *GetCommentLikedRequest) (*GetCommentLikedReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommentLiked not implemented")
+func (UnimplementedCommentServer) ListMemberReaction(context.Context, *ListMemberReactionRequest) (*ListMemberReactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberReaction not implemented")
 }
 func (UnimplementedCommentServer) GetComment(context.Context, *GetCommentRequest) (*GetCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
 }
+func (UnimplementedCommentServer) GetCommentStatus(context.Context, *GetCommentStatusRequest) (*GetCommentStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentStatus not implemented")
+}
+func (UnimplementedCommentServer) ListPendingComment(context.Context, *ListPendingCommentRequest) (*ListCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingComment not implemented")
+}
+func (UnimplementedCommentServer) ReviewComment(context.Context, *ReviewCommentRequest) (*ReviewCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewComment not implemented")
+}
+func (UnimplementedCommentServer) PinComment(context.Context, *PinCommentRequest) (*PinCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinComment not implemented")
+}
+func (UnimplementedCommentServer) UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinComment not implemented")
+}
+func (UnimplementedCommentServer) OpenSubject(context.Context, *OpenSubjectRequest) (*OpenSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSubject not implemented")
+}
+func (UnimplementedCommentServer) CloseSubject(context.Context, *CloseSubjectRequest) (*CloseSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSubject not implemented")
+}
+func (UnimplementedCommentServer) LockSubject(context.Context, *LockSubjectRequest) (*LockSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockSubject not implemented")
+}
+func (UnimplementedCommentServer) ListNotification(context.Context, *ListNotificationRequest) (*ListNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotification not implemented")
+}
+func (UnimplementedCommentServer) ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadNotification not implemented")
+}
+func (UnimplementedCommentServer) GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
 func (UnimplementedCommentServer) mustEmbedUnimplementedCommentServer() {}
 
 // UnsafeCommentServer may be embedded to opt out of forward compatibility for this service.
@@ -196,20 +456,38 @@ func _Comment_CreateComment_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Comment_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeCommentRequest)
+func _Comment_ReactComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ReactComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/ReactComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ReactComment(ctx, req.(*ReactCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServer).LikeComment(ctx, in)
+		return srv.(CommentServer).UpdateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.service.v1.Comment/LikeComment",
+		FullMethod: "/comment.service.v1.Comment/UpdateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServer).LikeComment(ctx, req.(*LikeCommentRequest))
+		return srv.(CommentServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -268,6 +546,24 @@ func _Comment_ListSubComment_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Comment_ListCommentsByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsByMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListCommentsByMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/ListCommentsByMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListCommentsByMember(ctx, req.(*ListCommentsByMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Comment_GetCommentSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommentSubjectRequest)
 	if err := dec(in); err != nil {
@@ -286,6 +582,60 @@ func _Comment_GetCommentSubject_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Comment_RegisterSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).RegisterSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/RegisterSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).RegisterSubject(ctx, req.(*RegisterSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_UpdateSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).UpdateSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/UpdateSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).UpdateSubject(ctx, req.(*UpdateSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_GetCommentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).GetCommentPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/GetCommentPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).GetCommentPolicy(ctx, req.(*GetCommentPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Comment_ListCommentSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCommentSubjectRequest)
 	if err := dec(in); err != nil {
@@ -304,20 +654,20 @@ func _Comment_ListCommentSubject_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Comment_GetCommentLiked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentLikedRequest)
+func _Comment_ListMemberReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServer).GetCommentLiked(ctx, in)
+		return srv.(CommentServer).ListMemberReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.service.v1.Comment/GetCommentLiked",
+		FullMethod: "/comment.service.v1.Comment/ListMemberReaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServer).GetCommentLiked(ctx, req.(*GetCommentLikedRequest))
+		return srv.(CommentServer).ListMemberReaction(ctx, req.(*ListMemberReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -340,6 +690,204 @@ func _Comment_GetComment_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Comment_GetCommentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).GetCommentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/GetCommentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).GetCommentStatus(ctx, req.(*GetCommentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ListPendingComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListPendingComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/ListPendingComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListPendingComment(ctx, req.(*ListPendingCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ReviewComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ReviewComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/ReviewComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ReviewComment(ctx, req.(*ReviewCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_PinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).PinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/PinComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).PinComment(ctx, req.(*PinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_UnpinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).UnpinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/UnpinComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).UnpinComment(ctx, req.(*UnpinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_OpenSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).OpenSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/OpenSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).OpenSubject(ctx, req.(*OpenSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_CloseSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).CloseSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/CloseSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).CloseSubject(ctx, req.(*CloseSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_LockSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).LockSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/LockSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).LockSubject(ctx, req.(*LockSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ListNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/ListNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListNotification(ctx, req.(*ListNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ReadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/ReadNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ReadNotification(ctx, req.(*ReadNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.service.v1.Comment/GetUnreadNotificationCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Comment_ServiceDesc is the grpc.ServiceDesc for Comment service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -352,8 +900,12 @@ var Comment_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Comment_CreateComment_Handler,
 		},
 		{
-			MethodName: "LikeComment",
-			Handler:    _Comment_LikeComment_Handler,
+			MethodName: "ReactComment",
+			Handler:    _Comment_ReactComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Comment_UpdateComment_Handler,
 		},
 		{
 			MethodName: "DeleteComment",
@@ -367,22 +919,82 @@ var Comment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubComment",
 			Handler:    _Comment_ListSubComment_Handler,
 		},
+		{
+			MethodName: "ListCommentsByMember",
+			Handler:    _Comment_ListCommentsByMember_Handler,
+		},
 		{
 			MethodName: "GetCommentSubject",
 			Handler:    _Comment_GetCommentSubject_Handler,
 		},
+		{
+			MethodName: "RegisterSubject",
+			Handler:    _Comment_RegisterSubject_Handler,
+		},
+		{
+			MethodName: "UpdateSubject",
+			Handler:    _Comment_UpdateSubject_Handler,
+		},
+		{
+			MethodName: "GetCommentPolicy",
+			Handler:    _Comment_GetCommentPolicy_Handler,
+		},
 		{
 			MethodName: "ListCommentSubject",
 			Handler:    _Comment_ListCommentSubject_Handler,
 		},
 		{
-			MethodName: "GetCommentLiked",
-			Handler:    _Comment_GetCommentLiked_Handler,
+			MethodName: "ListMemberReaction",
+			Handler:    _Comment_ListMemberReaction_Handler,
 		},
 		{
 			MethodName: "GetComment",
 			Handler:    _Comment_GetComment_Handler,
 		},
+		{
+			MethodName: "GetCommentStatus",
+			Handler:    _Comment_GetCommentStatus_Handler,
+		},
+		{
+			MethodName: "ListPendingComment",
+			Handler:    _Comment_ListPendingComment_Handler,
+		},
+		{
+			MethodName: "ReviewComment",
+			Handler:    _Comment_ReviewComment_Handler,
+		},
+		{
+			MethodName: "PinComment",
+			Handler:    _Comment_PinComment_Handler,
+		},
+		{
+			MethodName: "UnpinComment",
+			Handler:    _Comment_UnpinComment_Handler,
+		},
+		{
+			MethodName: "OpenSubject",
+			Handler:    _Comment_OpenSubject_Handler,
+		},
+		{
+			MethodName: "CloseSubject",
+			Handler:    _Comment_CloseSubject_Handler,
+		},
+		{
+			MethodName: "LockSubject",
+			Handler:    _Comment_LockSubject_Handler,
+		},
+		{
+			MethodName: "ListNotification",
+			Handler:    _Comment_ListNotification_Handler,
+		},
+		{
+			MethodName: "ReadNotification",
+			Handler:    _Comment_ReadNotification_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _Comment_GetUnreadNotificationCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/comment/service/v1/comment.proto",
@@ -18,28 +18,60 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 type CommentHTTPServer interface {
+	CloseSubject(context.Context, *CloseSubjectRequest) (*CloseSubjectReply, error)
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentReply, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error)
 	GetComment(context.Context, *GetCommentRequest) (*GetCommentReply, error)
-	GetCommentLiked(context.Context, *GetCommentLikedRequest) (*GetCommentLikedReply, error)
+	GetCommentPolicy(context.Context, *GetCommentPolicyRequest) (*GetCommentPolicyReply, error)
+	GetCommentStatus(context.Context, *GetCommentStatusRequest) (*GetCommentStatusReply, error)
 	GetCommentSubject(context.Context, *GetCommentSubjectRequest) (*GetCommentSubjectReply, error)
-	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error)
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountReply, error)
 	ListComment(context.Context, *ListCommentRequest) (*ListCommentReply, error)
 	ListCommentSubject(context.Context, *ListCommentSubjectRequest) (*ListCommentSubjectReply, error)
+	ListCommentsByMember(context.Context, *ListCommentsByMemberRequest) (*ListCommentReply, error)
+	ListMemberReaction(context.Context, *ListMemberReactionRequest) (*ListMemberReactionReply, error)
+	ListNotification(context.Context, *ListNotificationRequest) (*ListNotificationReply, error)
+	ListPendingComment(context.Context, *ListPendingCommentRequest) (*ListCommentReply, error)
 	ListSubComment(context.Context, *ListSubCommentRequest) (*ListCommentReply, error)
+	LockSubject(context.Context, *LockSubjectRequest) (*LockSubjectReply, error)
+	OpenSubject(context.Context, *OpenSubjectRequest) (*OpenSubjectReply, error)
+	PinComment(context.Context, *PinCommentRequest) (*PinCommentReply, error)
+	ReactComment(context.Context, *ReactCommentRequest) (*ReactCommentReply, error)
+	ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationReply, error)
+	RegisterSubject(context.Context, *RegisterSubjectRequest) (*RegisterSubjectReply, error)
+	ReviewComment(context.Context, *ReviewCommentRequest) (*ReviewCommentReply, error)
+	UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentReply, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error)
+	UpdateSubject(context.Context, *UpdateSubjectRequest) (*UpdateSubjectReply, error)
 }
 
 func RegisterCommentHTTPServer(s *http.Server, srv CommentHTTPServer) {
 	r := s.Route("/")
 	r.POST("/comment", _Comment_CreateComment0_HTTP_Handler(srv))
-	r.POST("/comment/like", _Comment_LikeComment0_HTTP_Handler(srv))
+	r.POST("/comment/react", _Comment_ReactComment0_HTTP_Handler(srv))
+	r.PUT("/comment", _Comment_UpdateComment0_HTTP_Handler(srv))
 	r.DELETE("/comment", _Comment_DeleteComment0_HTTP_Handler(srv))
 	r.GET("/comment/list", _Comment_ListComment0_HTTP_Handler(srv))
 	r.GET("/comment/sub/list", _Comment_ListSubComment0_HTTP_Handler(srv))
+	r.GET("/comment/member/list", _Comment_ListCommentsByMember0_HTTP_Handler(srv))
 	r.GET("/comment/subject", _Comment_GetCommentSubject0_HTTP_Handler(srv))
+	r.POST("/comment/subject", _Comment_RegisterSubject0_HTTP_Handler(srv))
+	r.PUT("/comment/subject", _Comment_UpdateSubject0_HTTP_Handler(srv))
+	r.GET("/comment/policy", _Comment_GetCommentPolicy0_HTTP_Handler(srv))
 	r.GET("/comment/subject/list", _Comment_ListCommentSubject0_HTTP_Handler(srv))
-	r.GET("/comment/liked", _Comment_GetCommentLiked0_HTTP_Handler(srv))
+	r.GET("/comment/reaction/member", _Comment_ListMemberReaction0_HTTP_Handler(srv))
 	r.GET("/comment/{id}", _Comment_GetComment0_HTTP_Handler(srv))
+	r.GET("/comment/{id}/status", _Comment_GetCommentStatus0_HTTP_Handler(srv))
+	r.GET("/comment/pending/list", _Comment_ListPendingComment0_HTTP_Handler(srv))
+	r.POST("/comment/review", _Comment_ReviewComment0_HTTP_Handler(srv))
+	r.POST("/comment/pin", _Comment_PinComment0_HTTP_Handler(srv))
+	r.POST("/comment/unpin", _Comment_UnpinComment0_HTTP_Handler(srv))
+	r.POST("/comment/subject/open", _Comment_OpenSubject0_HTTP_Handler(srv))
+	r.POST("/comment/subject/close", _Comment_CloseSubject0_HTTP_Handler(srv))
+	r.POST("/comment/subject/lock", _Comment_LockSubject0_HTTP_Handler(srv))
+	r.GET("/comment/notification/list", _Comment_ListNotification0_HTTP_Handler(srv))
+	r.POST("/comment/notification/read", _Comment_ReadNotification0_HTTP_Handler(srv))
+	r.GET("/comment/notification/unread", _Comment_GetUnreadNotificationCount0_HTTP_Handler(srv))
 }
 
 func _Comment_CreateComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
@@ -61,21 +93,40 @@ func _Comment_CreateComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.C
 	}
 }
 
-func _Comment_LikeComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+func _Comment_ReactComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LikeCommentRequest
+		var in ReactCommentRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/comment.service.v1.Comment/LikeComment")
+		http.SetOperation(ctx, "/comment.service.v1.Comment/ReactComment")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LikeComment(ctx, req.(*LikeCommentRequest))
+			return srv.ReactComment(ctx, req.(*ReactCommentRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*LikeCommentReply)
+		reply := out.(*ReactCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_UpdateComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/UpdateComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateComment(ctx, req.(*UpdateCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCommentReply)
 		return ctx.Result(200, reply)
 	}
 }
@@ -137,6 +188,25 @@ func _Comment_ListSubComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.
 	}
 }
 
+func _Comment_ListCommentsByMember0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCommentsByMemberRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/ListCommentsByMember")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCommentsByMember(ctx, req.(*ListCommentsByMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Comment_GetCommentSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetCommentSubjectRequest
@@ -156,6 +226,63 @@ func _Comment_GetCommentSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx ht
 	}
 }
 
+func _Comment_RegisterSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegisterSubjectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/RegisterSubject")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegisterSubject(ctx, req.(*RegisterSubjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterSubjectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_UpdateSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSubjectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/UpdateSubject")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSubject(ctx, req.(*UpdateSubjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateSubjectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_GetCommentPolicy0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/GetCommentPolicy")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCommentPolicy(ctx, req.(*GetCommentPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCommentPolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Comment_ListCommentSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListCommentSubjectRequest
@@ -175,21 +302,21 @@ func _Comment_ListCommentSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx h
 	}
 }
 
-func _Comment_GetCommentLiked0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+func _Comment_ListMemberReaction0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCommentLikedRequest
+		var in ListMemberReactionRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/comment.service.v1.Comment/GetCommentLiked")
+		http.SetOperation(ctx, "/comment.service.v1.Comment/ListMemberReaction")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCommentLiked(ctx, req.(*GetCommentLikedRequest))
+			return srv.ListMemberReaction(ctx, req.(*ListMemberReactionRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCommentLikedReply)
+		reply := out.(*ListMemberReactionReply)
 		return ctx.Result(200, reply)
 	}
 }
@@ -216,16 +343,244 @@ func _Comment_GetComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Comment_GetCommentStatus0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/GetCommentStatus")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCommentStatus(ctx, req.(*GetCommentStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCommentStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_ListPendingComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPendingCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/ListPendingComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPendingComment(ctx, req.(*ListPendingCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_ReviewComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReviewCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/ReviewComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReviewComment(ctx, req.(*ReviewCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_PinComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PinCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/PinComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PinComment(ctx, req.(*PinCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PinCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_UnpinComment0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnpinCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/UnpinComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnpinComment(ctx, req.(*UnpinCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnpinCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_OpenSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OpenSubjectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/OpenSubject")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OpenSubject(ctx, req.(*OpenSubjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OpenSubjectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_CloseSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CloseSubjectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/CloseSubject")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CloseSubject(ctx, req.(*CloseSubjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CloseSubjectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_LockSubject0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LockSubjectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/LockSubject")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LockSubject(ctx, req.(*LockSubjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LockSubjectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_ListNotification0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListNotificationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/ListNotification")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNotification(ctx, req.(*ListNotificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListNotificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_ReadNotification0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReadNotificationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/ReadNotification")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReadNotification(ctx, req.(*ReadNotificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReadNotificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Comment_GetUnreadNotificationCount0_HTTP_Handler(srv CommentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUnreadNotificationCountRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/comment.service.v1.Comment/GetUnreadNotificationCount")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUnreadNotificationCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type CommentHTTPClient interface {
+	CloseSubject(ctx context.Context, req *CloseSubjectRequest, opts ...http.CallOption) (rsp *CloseSubjectReply, err error)
 	CreateComment(ctx context.Context, req *CreateCommentRequest, opts ...http.CallOption) (rsp *CreateCommentReply, err error)
 	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *DeleteCommentReply, err error)
 	GetComment(ctx context.Context, req *GetCommentRequest, opts ...http.CallOption) (rsp *GetCommentReply, err error)
-	GetCommentLiked(ctx context.Context, req *GetCommentLikedRequest, opts ...http.CallOption) (rsp *GetCommentLikedReply, err error)
+	GetCommentPolicy(ctx context.Context, req *GetCommentPolicyRequest, opts ...http.CallOption) (rsp *GetCommentPolicyReply, err error)
+	GetCommentStatus(ctx context.Context, req *GetCommentStatusRequest, opts ...http.CallOption) (rsp *GetCommentStatusReply, err error)
 	GetCommentSubject(ctx context.Context, req *GetCommentSubjectRequest, opts ...http.CallOption) (rsp *GetCommentSubjectReply, err error)
-	LikeComment(ctx context.Context, req *LikeCommentRequest, opts ...http.CallOption) (rsp *LikeCommentReply, err error)
+	GetUnreadNotificationCount(ctx context.Context, req *GetUnreadNotificationCountRequest, opts ...http.CallOption) (rsp *GetUnreadNotificationCountReply, err error)
 	ListComment(ctx context.Context, req *ListCommentRequest, opts ...http.CallOption) (rsp *ListCommentReply, err error)
 	ListCommentSubject(ctx context.Context, req *ListCommentSubjectRequest, opts ...http.CallOption) (rsp *ListCommentSubjectReply, err error)
+	ListCommentsByMember(ctx context.Context, req *ListCommentsByMemberRequest, opts ...http.CallOption) (rsp *ListCommentReply, err error)
+	ListMemberReaction(ctx context.Context, req *ListMemberReactionRequest, opts ...http.CallOption) (rsp *ListMemberReactionReply, err error)
+	ListNotification(ctx context.Context, req *ListNotificationRequest, opts ...http.CallOption) (rsp *ListNotificationReply, err error)
+	ListPendingComment(ctx context.Context, req *ListPendingCommentRequest, opts ...http.CallOption) (rsp *ListCommentReply, err error)
 	ListSubComment(ctx context.Context, req *ListSubCommentRequest, opts ...http.CallOption) (rsp *ListCommentReply, err error)
+	LockSubject(ctx context.Context, req *LockSubjectRequest, opts ...http.CallOption) (rsp *LockSubjectReply, err error)
+	OpenSubject(ctx context.Context, req *OpenSubjectRequest, opts ...http.CallOption) (rsp *OpenSubjectReply, err error)
+	PinComment(ctx context.Context, req *PinCommentRequest, opts ...http.CallOption) (rsp *PinCommentReply, err error)
+	ReactComment(ctx context.Context, req *ReactCommentRequest, opts ...http.CallOption) (rsp *ReactCommentReply, err error)
+	ReadNotification(ctx context.Context, req *ReadNotificationRequest, opts ...http.CallOption) (rsp *ReadNotificationReply, err error)
+	RegisterSubject(ctx context.Context, req *RegisterSubjectRequest, opts ...http.CallOption) (rsp *RegisterSubjectReply, err error)
+	ReviewComment(ctx context.Context, req *ReviewCommentRequest, opts ...http.CallOption) (rsp *ReviewCommentReply, err error)
+	UnpinComment(ctx context.Context, req *UnpinCommentRequest, opts ...http.CallOption) (rsp *UnpinCommentReply, err error)
+	UpdateComment(ctx context.Context, req *UpdateCommentRequest, opts ...http.CallOption) (rsp *UpdateCommentReply, err error)
+	UpdateSubject(ctx context.Context, req *UpdateSubjectRequest, opts ...http.CallOption) (rsp *UpdateSubjectReply, err error)
 }
 
 type CommentHTTPClientImpl struct {
@@ -236,6 +591,19 @@ func NewCommentHTTPClient(client *http.Client) CommentHTTPClient {
 	return &CommentHTTPClientImpl{client}
 }
 
+func (c *CommentHTTPClientImpl) CloseSubject(ctx context.Context, in *CloseSubjectRequest, opts ...http.CallOption) (*CloseSubjectReply, error) {
+	var out CloseSubjectReply
+	pattern := "/comment/subject/close"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/CloseSubject"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *CommentHTTPClientImpl) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...http.CallOption) (*CreateCommentReply, error) {
 	var out CreateCommentReply
 	pattern := "/comment"
@@ -275,11 +643,24 @@ func (c *CommentHTTPClientImpl) GetComment(ctx context.Context, in *GetCommentRe
 	return &out, err
 }
 
-func (c *CommentHTTPClientImpl) GetCommentLiked(ctx context.Context, in *GetCommentLikedRequest, opts ...http.CallOption) (*GetCommentLikedReply, error) {
-	var out GetCommentLikedReply
-	pattern := "/comment/liked"
+func (c *CommentHTTPClientImpl) GetCommentPolicy(ctx context.Context, in *GetCommentPolicyRequest, opts ...http.CallOption) (*GetCommentPolicyReply, error) {
+	var out GetCommentPolicyReply
+	pattern := "/comment/policy"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation("/comment.service.v1.Comment/GetCommentLiked"))
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/GetCommentPolicy"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) GetCommentStatus(ctx context.Context, in *GetCommentStatusRequest, opts ...http.CallOption) (*GetCommentStatusReply, error) {
+	var out GetCommentStatusReply
+	pattern := "/comment/{id}/status"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/GetCommentStatus"))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -301,13 +682,13 @@ func (c *CommentHTTPClientImpl) GetCommentSubject(ctx context.Context, in *GetCo
 	return &out, err
 }
 
-func (c *CommentHTTPClientImpl) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...http.CallOption) (*LikeCommentReply, error) {
-	var out LikeCommentReply
-	pattern := "/comment/like"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation("/comment.service.v1.Comment/LikeComment"))
+func (c *CommentHTTPClientImpl) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...http.CallOption) (*GetUnreadNotificationCountReply, error) {
+	var out GetUnreadNotificationCountReply
+	pattern := "/comment/notification/unread"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/GetUnreadNotificationCount"))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -340,6 +721,58 @@ func (c *CommentHTTPClientImpl) ListCommentSubject(ctx context.Context, in *List
 	return &out, err
 }
 
+func (c *CommentHTTPClientImpl) ListCommentsByMember(ctx context.Context, in *ListCommentsByMemberRequest, opts ...http.CallOption) (*ListCommentReply, error) {
+	var out ListCommentReply
+	pattern := "/comment/member/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/ListCommentsByMember"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) ListMemberReaction(ctx context.Context, in *ListMemberReactionRequest, opts ...http.CallOption) (*ListMemberReactionReply, error) {
+	var out ListMemberReactionReply
+	pattern := "/comment/reaction/member"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/ListMemberReaction"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) ListNotification(ctx context.Context, in *ListNotificationRequest, opts ...http.CallOption) (*ListNotificationReply, error) {
+	var out ListNotificationReply
+	pattern := "/comment/notification/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/ListNotification"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) ListPendingComment(ctx context.Context, in *ListPendingCommentRequest, opts ...http.CallOption) (*ListCommentReply, error) {
+	var out ListCommentReply
+	pattern := "/comment/pending/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/ListPendingComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *CommentHTTPClientImpl) ListSubComment(ctx context.Context, in *ListSubCommentRequest, opts ...http.CallOption) (*ListCommentReply, error) {
 	var out ListCommentReply
 	pattern := "/comment/sub/list"
@@ -352,3 +785,133 @@ func (c *CommentHTTPClientImpl) ListSubComment(ctx context.Context, in *ListSubC
 	}
 	return &out, err
 }
+
+func (c *CommentHTTPClientImpl) LockSubject(ctx context.Context, in *LockSubjectRequest, opts ...http.CallOption) (*LockSubjectReply, error) {
+	var out LockSubjectReply
+	pattern := "/comment/subject/lock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/LockSubject"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) OpenSubject(ctx context.Context, in *OpenSubjectRequest, opts ...http.CallOption) (*OpenSubjectReply, error) {
+	var out OpenSubjectReply
+	pattern := "/comment/subject/open"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/OpenSubject"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) PinComment(ctx context.Context, in *PinCommentRequest, opts ...http.CallOption) (*PinCommentReply, error) {
+	var out PinCommentReply
+	pattern := "/comment/pin"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/PinComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) ReactComment(ctx context.Context, in *ReactCommentRequest, opts ...http.CallOption) (*ReactCommentReply, error) {
+	var out ReactCommentReply
+	pattern := "/comment/react"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/ReactComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) ReadNotification(ctx context.Context, in *ReadNotificationRequest, opts ...http.CallOption) (*ReadNotificationReply, error) {
+	var out ReadNotificationReply
+	pattern := "/comment/notification/read"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/ReadNotification"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) RegisterSubject(ctx context.Context, in *RegisterSubjectRequest, opts ...http.CallOption) (*RegisterSubjectReply, error) {
+	var out RegisterSubjectReply
+	pattern := "/comment/subject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/RegisterSubject"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) ReviewComment(ctx context.Context, in *ReviewCommentRequest, opts ...http.CallOption) (*ReviewCommentReply, error) {
+	var out ReviewCommentReply
+	pattern := "/comment/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/ReviewComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...http.CallOption) (*UnpinCommentReply, error) {
+	var out UnpinCommentReply
+	pattern := "/comment/unpin"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/UnpinComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...http.CallOption) (*UpdateCommentReply, error) {
+	var out UpdateCommentReply
+	pattern := "/comment"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/UpdateComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *CommentHTTPClientImpl) UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...http.CallOption) (*UpdateSubjectReply, error) {
+	var out UpdateSubjectReply
+	pattern := "/comment/subject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/comment.service.v1.Comment/UpdateSubject"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -31,6 +31,7 @@ type AccountRepo interface {
 	GetAccountByOpenId(ctx context.Context, openId string) (*Account, error)
 	UpdateAccount(ctx context.Context, account *Account) error
 	GetAccountByIds(ctx context.Context, ids []uint64) ([]*Account, error)
+	GetAccountByNicknames(ctx context.Context, nicknames []string) ([]*Account, error)
 }
 
 type AccountUsecase struct {
@@ -74,6 +75,14 @@ func (uc *AccountUsecase) GetAccountByIds(ctx context.Context, ids []uint64) ([]
 	return uc.repo.GetAccountByIds(ctx, ids)
 }
 
+// GetAccountByNicknames 按昵称批量查询账户
+func (uc *AccountUsecase) GetAccountByNicknames(ctx context.Context, nicknames []string) ([]*Account, error) {
+	if len(nicknames) == 0 {
+		return nil, nil
+	}
+	return uc.repo.GetAccountByNicknames(ctx, nicknames)
+}
+
 // EmailLogin 邮箱登录
 func (uc *AccountUsecase) EmailLogin(ctx context.Context, account *Account) error {
 	savedAccount, err := uc.repo.GetAccount(ctx, account.Email)
@@ -124,6 +124,20 @@ func (a accountRepo) GetAccountByIds(ctx context.Context, ids []uint64) ([]*biz.
 	return ret, nil
 }
 
+func (a accountRepo) GetAccountByNicknames(ctx context.Context, nicknames []string) ([]*biz.Account, error) {
+	var accounts []*Account
+	result := a.data.db.WithContext(ctx).Where("nickname IN ?", nicknames).
+		Find(&accounts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	ret := make([]*biz.Account, len(accounts))
+	for i := range accounts {
+		ret[i] = toBizAccount(accounts[i])
+	}
+	return ret, nil
+}
+
 func toBizAccount(account *Account) *biz.Account {
 	return &biz.Account{
 		Id:        account.Id,
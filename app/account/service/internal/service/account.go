@@ -83,6 +83,18 @@ func (s *AccountService) ListWithIds(ctx context.Context, req *pb.ListWithIdsReq
 	}
 	return &pb.ListWithIdsReply{Accounts: result}, nil
 }
+
+func (s *AccountService) ListWithNicknames(ctx context.Context, req *pb.ListWithNicknamesRequest) (*pb.ListWithNicknamesReply, error) {
+	accounts, err := s.uc.GetAccountByNicknames(ctx, req.Nicknames)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.AccountInfo, len(accounts))
+	for i := range accounts {
+		result[i] = toAccountInfo(accounts[i])
+	}
+	return &pb.ListWithNicknamesReply{Accounts: result}, nil
+}
 func (s *AccountService) EmailLogin(ctx context.Context, req *pb.EmailLoginRequest) (*pb.AccountLoginReply, error) {
 	account := &biz.Account{
 		Email: req.Email,
@@ -30,7 +30,13 @@ func initApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Da
 	accountRepo := data.NewAccountRepo(dataData, logger)
 	commentUsecase := biz.NewCommentUsecase(commentRepo, accountRepo, logger)
 	accountUsecase := biz.NewAccountUsecase(accountRepo, logger)
-	baseappInterfaceService := service.NewBaseappInterfaceService(commentUsecase, accountUsecase, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, accountRepo, logger)
+	baseappInterfaceService, err := service.NewBaseappInterfaceService(confServer, commentUsecase, accountUsecase, notificationUsecase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	httpServer := server.NewHTTPServer(confServer, baseappInterfaceService, logger)
 	grpcServer := server.NewGRPCServer(confServer, baseappInterfaceService, logger)
 	app := newApp(logger, httpServer, grpcServer)
@@ -20,6 +20,7 @@ type Account struct {
 type AccountRepo interface {
 	GetAccount(ctx context.Context, id uint64) (*Account, error)
 	ListByIds(ctx context.Context, ids []uint64) ([]*Account, error)
+	ListByNicknames(ctx context.Context, nicknames []string) ([]*Account, error)
 	Login(ctx context.Context, email string, password string) (*Account, string, error)
 }
 
@@ -8,6 +8,13 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// 评论主题状态, 与评论服务一致
+const (
+	SubjectStateOpen int8 = iota // 开放
+	SubjectStateClosed           // 关闭, 不能发表新评论
+	SubjectStateLocked           // 锁定, 评论区只读
+)
+
 type CommentSubject struct {
 	Id uint64
 	ObjId uint64
@@ -17,12 +24,17 @@ type CommentSubject struct {
 	RootCount int
 	AllCount int
 	State int8
+	Title string
+	Url string
+	Attributes map[string]string
 	CreatedAt int64
 	UpdatedAt int64
 }
 
 type Comment struct {
 	Id uint64
+	ObjId uint64
+	ObjType int
 	MemberId uint64
 	Nickname string
 	Avatar string
@@ -44,19 +56,60 @@ type Comment struct {
 	Device string
 	Message string
 	Meta string
+	Edited bool
+	EditedAt int64
+	Pinned bool
+	Reactions map[string]int
+	MyReaction string
+	Mentions []Mention
+	Queued bool // 已发表但尚未保存, 只对作者可见
+	IdempotencyKey string // 客户端请求id, 超时重试时沿用, 避免重复发表
 	CreatedAt int64
 	UpdatedAt int64
 	Replies []*Comment
 }
 
+// CommentPolicy obj_type 的评论策略, 由评论服务的配置决定
+type CommentPolicy struct {
+	MaxMessageLength int // 为0时不限制
+	MaxReplyDepth int // 1 只允许根评论, 2 允许回复根评论, 为0时不限制
+	DefaultSort int
+	AnonymousRead bool
+	ReplyPreviewCount int
+}
+
+// CommentStatus 评论的保存状态, State 只在已保存时有效
+type CommentStatus struct {
+	Status int8
+	State int8
+}
+
+// CommentPage 一页评论, NextCursor 原样透传评论服务返回的游标
+type CommentPage struct {
+	Comments []*Comment
+	NextCursor string
+	HasMore bool
+}
+
 type CommentRepo interface {
 	GetCommentSubject(ctx context.Context, subject *CommentSubject) error
+	// GetCommentPolicy 查询 obj_type 的评论策略, 评论服务重新加载策略后短时间内可能仍返回旧的策略
+	GetCommentPolicy(ctx context.Context, objType int) (*CommentPolicy, error)
 	SaveComment(ctx context.Context, subject *CommentSubject, comment *Comment) error
-	GetComment(ctx context.Context, id uint64) (*Comment, error)
-	GetCommentList(ctx context.Context, subject *CommentSubject, page, size, replyCount int) ([]*Comment, error)
-	GetReplyList(ctx context.Context, rootId uint64, page, size int) ([] *Comment, error)
-	LikeComment(ctx context.Context, comment *Comment) error
-	GetLikeItem(ctx context.Context, memberId uint64, commentIds []uint64) (map[uint64]bool, error)
+	// GetComment 查询评论, memberId 为作者时可以查询到尚未保存的评论
+	GetComment(ctx context.Context, id uint64, memberId uint64) (*Comment, error)
+	// GetCommentStatus 查询 memberId 发表的评论的保存状态, 其他成员的评论返回评论不存在
+	GetCommentStatus(ctx context.Context, id uint64, memberId uint64) (*CommentStatus, error)
+	// GetCommentList 查询根评论, 第一页会合并 memberId 尚未保存的评论, sort 与 replyCount 小于0时使用 obj_type 的默认值
+	GetCommentList(ctx context.Context, subject *CommentSubject, memberId uint64, sort int, cursor string, size, replyCount int) (*CommentPage, error)
+	GetReplyList(ctx context.Context, rootId uint64, cursor string, size int) (*CommentPage, error)
+	ListCommentsByMember(ctx context.Context, memberId uint64, cursor string, size int) (*CommentPage, error)
+	ReactComment(ctx context.Context, comment *Comment) error
+	ListMemberReaction(ctx context.Context, memberId uint64, commentIds []uint64) (map[uint64]string, error)
+	UpdateComment(ctx context.Context, comment *Comment) error
+	DeleteComment(ctx context.Context, comment *Comment) error
+	PinComment(ctx context.Context, comment *Comment) error
+	UnpinComment(ctx context.Context, comment *Comment) error
 }
 
 type CommentUsecase struct {
@@ -77,12 +130,32 @@ func (uc *CommentUsecase) GetCommentSubject(ctx context.Context, subject *Commen
 	return uc.repo.GetCommentSubject(ctx, subject)
 }
 
+func (uc *CommentUsecase) GetCommentPolicy(ctx context.Context, objType int) (*CommentPolicy, error) {
+	return uc.repo.GetCommentPolicy(ctx, objType)
+}
+
+// CanRead 未登录用户只能查看允许匿名查看的 obj_type 的评论
+func (uc *CommentUsecase) CanRead(ctx context.Context, objType int) (bool, error) {
+	if _, err := token.ExtractUid(ctx); err == nil {
+		return true, nil
+	}
+	policy, err := uc.repo.GetCommentPolicy(ctx, objType)
+	if err != nil {
+		return false, err
+	}
+	return policy.AnonymousRead, nil
+}
+
+// SaveComment 发表评论, 内容中的 @昵称 解析为成员后一并保存
 func (uc *CommentUsecase) SaveComment(ctx context.Context, subject *CommentSubject, comment *Comment) error {
+	uc.resolveMentions(ctx, comment)
 	return uc.repo.SaveComment(ctx, subject, comment)
 }
 
+// GetComment 查询评论, 登录用户可以查询到自己刚发表尚未保存的评论
 func (uc *CommentUsecase) GetComment(ctx context.Context, id uint64) (*Comment, error) {
-	comment, err := uc.repo.GetComment(ctx, id)
+	uid, _ := token.ExtractUid(ctx)
+	comment, err := uc.repo.GetComment(ctx, id, uid)
 	if err != nil {
 		return nil, err
 	}
@@ -98,71 +171,119 @@ func (uc *CommentUsecase) GetComment(ctx context.Context, id uint64) (*Comment,
 }
 
 func (uc *CommentUsecase) GetCommentList(ctx context.Context, subject *CommentSubject,
-	page, size, replyCount int) ([]*Comment, error) {
-	comments, err := uc.repo.GetCommentList(ctx, subject, page, size, replyCount)
+	sort int, cursor string, size, replyCount int) (*CommentPage, error) {
+	uid, uidErr := token.ExtractUid(ctx)
+	page, err := uc.repo.GetCommentList(ctx, subject, uid, sort, cursor, size, replyCount)
 	if err != nil {
 		return nil, err
 	}
+	comments := page.Comments
 	// 并发查询附加信息
 	group, _ := errgroup.WithContext(ctx)
 	var accounts []*Account
-	likedMap := make(map[uint64]bool)
+	reactionMap := make(map[uint64]string)
 	group.Go(func() error {
 		accounts, err = uc.accountRepo.ListByIds(ctx, getCommentMemberIds(comments))
 		return err
 	})
-	// 如果有用户信息，查询用户是否点过赞
-	if uid, err := token.ExtractUid(ctx); err == nil {
+	// 如果有用户信息，查询用户对评论的表态
+	if uidErr == nil {
 		group.Go(func() error {
-			temp, err := uc.repo.GetLikeItem(ctx, uid, getCommentIds(comments))
-			likedMap = temp
+			temp, err := uc.repo.ListMemberReaction(ctx, uid, getCommentIds(comments))
+			reactionMap = temp
 			return err
 		})
 	}
 	if err = group.Wait(); err != nil {
 		return nil, err
 	}
-	concatMemberInfo(comments, accounts, likedMap)
-	return comments, nil
+	concatMemberInfo(comments, accounts, reactionMap)
+	return page, nil
+}
+
+// GetCommentStatus 查询自己发表的评论的保存状态
+func (uc *CommentUsecase) GetCommentStatus(ctx context.Context, id uint64, memberId uint64) (*CommentStatus, error) {
+	return uc.repo.GetCommentStatus(ctx, id, memberId)
 }
 
-func (uc *CommentUsecase) GetReplyList(ctx context.Context, rootId uint64, page, size int) ([]*Comment, error) {
-	comments, err := uc.repo.GetReplyList(ctx, rootId, page, size)
+func (uc *CommentUsecase) GetReplyList(ctx context.Context, rootId uint64, cursor string, size int) (*CommentPage, error) {
+	page, err := uc.repo.GetReplyList(ctx, rootId, cursor, size)
 	if err != nil {
 		return nil, err
 	}
+	comments := page.Comments
 	accounts, err := uc.accountRepo.ListByIds(ctx, getCommentMemberIds(comments))
 	if err != nil {
 		return nil, err
 	}
-	// 如果有用户信息，查询用户是否点过赞
-	likedMap := make(map[uint64]bool)
+	// 如果有用户信息，查询用户对评论的表态
+	reactionMap := make(map[uint64]string)
 	if uid, err := token.ExtractUid(ctx); err == nil {
-		temp, err := uc.repo.GetLikeItem(ctx, uid, getCommentIds(comments))
+		temp, err := uc.repo.ListMemberReaction(ctx, uid, getCommentIds(comments))
 		if err != nil {
-			uc.log.Errorf("查询用户是否点赞失败！%v \n", err)
+			uc.log.Errorf("查询用户表态失败！%v \n", err)
 		} else {
-			likedMap = temp
+			reactionMap = temp
 		}
 	}
-	concatMemberInfo(comments, accounts, likedMap)
-	return comments, nil
+	concatMemberInfo(comments, accounts, reactionMap)
+	return page, nil
 }
 
-func (uc *CommentUsecase) LikeComment(ctx context.Context, comment *Comment) error {
-	if comment.Like >= 0 {
-		comment.Like = 1
-	} else {
-		comment.Like = -1
+// ListMyComment 查询成员自己发表的评论, 附带回复对象的成员信息与自己的表态
+func (uc *CommentUsecase) ListMyComment(ctx context.Context, memberId uint64, cursor string, size int) (*CommentPage, error) {
+	page, err := uc.repo.ListCommentsByMember(ctx, memberId, cursor, size)
+	if err != nil {
+		return nil, err
+	}
+	comments := page.Comments
+	accounts, err := uc.accountRepo.ListByIds(ctx, getCommentMemberIds(comments))
+	if err != nil {
+		return nil, err
+	}
+	reactionMap, err := uc.repo.ListMemberReaction(ctx, memberId, getCommentIds(comments))
+	if err != nil {
+		uc.log.Errorf("查询用户表态失败！%v \n", err)
+		reactionMap = make(map[uint64]string)
 	}
-	return uc.repo.LikeComment(ctx, comment)
+	concatMemberInfo(comments, accounts, reactionMap)
+	return page, nil
+}
+
+// ReactComment 对评论表态, MyReaction 为空时取消表态
+func (uc *CommentUsecase) ReactComment(ctx context.Context, comment *Comment) error {
+	return uc.repo.ReactComment(ctx, comment)
 }
 
+// UpdateComment 编辑评论, 由评论服务校验作者与编辑时限, 修改后的内容重新解析 @昵称
+func (uc *CommentUsecase) UpdateComment(ctx context.Context, comment *Comment) error {
+	uc.resolveMentions(ctx, comment)
+	return uc.repo.UpdateComment(ctx, comment)
+}
+
+// PinComment 置顶评论, 由评论服务校验操作人是否为主题所有者
+func (uc *CommentUsecase) PinComment(ctx context.Context, comment *Comment) error {
+	return uc.repo.PinComment(ctx, comment)
+}
+
+// UnpinComment 取消置顶评论
+func (uc *CommentUsecase) UnpinComment(ctx context.Context, comment *Comment) error {
+	return uc.repo.UnpinComment(ctx, comment)
+}
 
+// DeleteComment 删除评论, 由评论服务校验操作人是否为作者
+func (uc *CommentUsecase) DeleteComment(ctx context.Context, comment *Comment) error {
+	return uc.repo.DeleteComment(ctx, comment)
+}
+
+// getCommentIds 评论及其回复的id
 func getCommentIds(comments []*Comment) []uint64 {
-	result := make([]uint64, len(comments))
+	result := make([]uint64, 0, len(comments))
 	for i := range comments {
-		result[i] = comments[i].Id
+		result = append(result, comments[i].Id)
+		for j := range comments[i].Replies {
+			result = append(result, comments[i].Replies[j].Id)
+		}
 	}
 	return result
 }
@@ -185,13 +306,13 @@ func getCommentMemberIds(comments []*Comment) []uint64 {
 	return ids
 }
 
-func concatMemberInfo(comments []*Comment, accounts []*Account, likedMap map[uint64]bool) {
+func concatMemberInfo(comments []*Comment, accounts []*Account, reactionMap map[uint64]string) {
 	accountMap := make(map[uint64]*Account)
 	for i := range accounts {
 		accountMap[accounts[i].Id] = accounts[i]
 	}
 	for i := range comments {
-		comments[i].Liked = likedMap[comments[i].Id]
+		setMyReaction(comments[i], reactionMap[comments[i].Id])
 		if account := accountMap[comments[i].MemberId]; account != nil {
 			comments[i].Nickname = account.Nickname
 			comments[i].Avatar = account.Avatar
@@ -202,6 +323,7 @@ func concatMemberInfo(comments []*Comment, accounts []*Account, likedMap map[uin
 		}
 		// sub comments
 		for j := range comments[i].Replies {
+			setMyReaction(comments[i].Replies[j], reactionMap[comments[i].Replies[j].Id])
 			if account := accountMap[comments[i].Replies[j].MemberId]; account != nil {
 				comments[i].Replies[j].Nickname = account.Nickname
 				comments[i].Replies[j].Avatar = account.Avatar
@@ -214,3 +336,8 @@ func concatMemberInfo(comments []*Comment, accounts []*Account, likedMap map[uin
 	}
 }
 
+// setMyReaction 当前用户的表态, 点赞同时保留 Liked 标记
+func setMyReaction(comment *Comment, reaction string) {
+	comment.MyReaction = reaction
+	comment.Liked = reaction == "like"
+}
@@ -0,0 +1,87 @@
+package biz
+
+import (
+	"context"
+	"unicode"
+)
+
+// 每条评论最多查询的昵称数, 评论服务会再按配置截断
+const maxMentionNicknames = 20
+
+// Mention 评论中 @ 的成员, Start 与 End 为 @昵称 在内容中的字符下标, 左闭右开
+type Mention struct {
+	MemberId uint64
+	Start int
+	End int
+}
+
+// mentionToken 内容中的一个 @昵称
+type mentionToken struct {
+	Nickname string
+	Start int
+	End int
+}
+
+// parseMentions 解析内容中的 @昵称, 昵称由字母、数字、下划线与连字符组成
+// @ 前紧跟昵称字符时(如邮箱地址)不视为 @
+func parseMentions(message string) []mentionToken {
+	runes := []rune(message)
+	var tokens []mentionToken
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' || (i > 0 && isNicknameRune(runes[i - 1])) {
+			continue
+		}
+		j := i + 1
+		for j < len(runes) && isNicknameRune(runes[j]) {
+			j++
+		}
+		if j > i + 1 {
+			tokens = append(tokens, mentionToken{Nickname: string(runes[i + 1 : j]), Start: i, End: j})
+			i = j - 1
+		}
+	}
+	return tokens
+}
+
+func isNicknameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
+
+// resolveMentions 通过账户服务将内容中的 @昵称 解析为成员, 昵称不存在或对应多个账户时忽略
+// 查询账户失败时不影响发表评论
+func (uc *CommentUsecase) resolveMentions(ctx context.Context, comment *Comment) {
+	comment.Mentions = nil
+	tokens := parseMentions(comment.Message)
+	if len(tokens) == 0 {
+		return
+	}
+	var nicknames []string
+	seen := make(map[string]bool)
+	for _, t := range tokens {
+		if !seen[t.Nickname] && len(nicknames) < maxMentionNicknames {
+			seen[t.Nickname] = true
+			nicknames = append(nicknames, t.Nickname)
+		}
+	}
+	accounts, err := uc.accountRepo.ListByNicknames(ctx, nicknames)
+	if err != nil {
+		uc.log.Errorf("list account by nicknames err: %v", err)
+		return
+	}
+	counts := make(map[string]int)
+	memberIds := make(map[string]uint64)
+	for _, account := range accounts {
+		counts[account.Nickname]++
+		memberIds[account.Nickname] = account.Id
+	}
+	for _, t := range tokens {
+		if counts[t.Nickname] != 1 {
+			continue
+		}
+		comment.Mentions = append(comment.Mentions, Mention{
+			MemberId: memberIds[t.Nickname],
+			Start: t.Start,
+			End: t.End,
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Notification 成员收到的通知, 附带最近一次触发通知的成员信息
+type Notification struct {
+	Id uint64
+	Type int8
+	CommentId uint64
+	ObjId uint64
+	ObjType int
+	ActorId uint64
+	ActorNickname string
+	ActorAvatar string
+	ActorCount int
+	Read bool
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+type NotificationRepo interface {
+	ListNotification(ctx context.Context, memberId uint64, page, size int) ([]*Notification, error)
+	ReadNotification(ctx context.Context, memberId uint64, ids []uint64) error
+	GetUnreadNotificationCount(ctx context.Context, memberId uint64) (int, error)
+}
+
+type NotificationUsecase struct {
+	repo NotificationRepo
+	accountRepo AccountRepo
+	log *log.Helper
+}
+
+func NewNotificationUsecase(repo NotificationRepo, accountRepo AccountRepo, logger log.Logger) *NotificationUsecase {
+	return &NotificationUsecase{
+		repo: repo,
+		accountRepo: accountRepo,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (uc *NotificationUsecase) ListNotification(ctx context.Context, memberId uint64, page, size int) ([]*Notification, error) {
+	notifications, err := uc.repo.ListNotification(ctx, memberId, page, size)
+	if err != nil || len(notifications) == 0 {
+		return notifications, err
+	}
+	ids := make([]uint64, len(notifications))
+	for i, item := range notifications {
+		ids[i] = item.ActorId
+	}
+	accounts, err := uc.accountRepo.ListByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	accountMap := make(map[uint64]*Account, len(accounts))
+	for _, account := range accounts {
+		accountMap[account.Id] = account
+	}
+	for _, item := range notifications {
+		if account, ok := accountMap[item.ActorId]; ok {
+			item.ActorNickname = account.Nickname
+			item.ActorAvatar = account.Avatar
+		}
+	}
+	return notifications, nil
+}
+
+// ReadNotification 标记通知已读, ids 为空时全部标记已读
+func (uc *NotificationUsecase) ReadNotification(ctx context.Context, memberId uint64, ids []uint64) error {
+	return uc.repo.ReadNotification(ctx, memberId, ids)
+}
+
+func (uc *NotificationUsecase) GetUnreadNotificationCount(ctx context.Context, memberId uint64) (int, error) {
+	return uc.repo.GetUnreadNotificationCount(ctx, memberId)
+}
@@ -42,6 +42,18 @@ func (a accountRepo) ListByIds(ctx context.Context, ids []uint64) ([]*biz.Accoun
 	return accounts, nil
 }
 
+func (a accountRepo) ListByNicknames(ctx context.Context, nicknames []string) ([]*biz.Account, error) {
+	result, err := a.data.ac.ListWithNicknames(ctx, &v1.ListWithNicknamesRequest{Nicknames: nicknames})
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*biz.Account, len(result.Accounts))
+	for i := range result.Accounts {
+		accounts[i] = toBizAccount(result.Accounts[i])
+	}
+	return accounts, nil
+}
+
 func (a accountRepo) Login(ctx context.Context, email string, password string) (*biz.Account, string, error) {
 	result, err := a.data.ac.EmailLogin(ctx, &v1.EmailLoginRequest{
 		Email:    email,
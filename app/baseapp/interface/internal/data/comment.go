@@ -5,20 +5,52 @@ import (
 	"base-service/app/baseapp/interface/internal/biz"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
 )
 
+// policyTTL 评论策略的本地缓存时长, 评论服务重新加载策略后最迟在这段时间后生效
+const policyTTL = time.Minute
+
+type cachedPolicy struct {
+	policy *biz.CommentPolicy
+	expireAt time.Time
+}
+
 type commentRepo struct {
 	log *log.Helper
 	data *Data
+	policies *sync.Map // obj_type -> *cachedPolicy
 }
 
 func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
 	return &commentRepo{
 		log:  log.NewHelper(logger),
 		data: data,
+		policies: &sync.Map{},
 	}
 }
 
+func (c commentRepo) GetCommentPolicy(ctx context.Context, objType int) (*biz.CommentPolicy, error) {
+	if v, ok := c.policies.Load(objType); ok && time.Now().Before(v.(*cachedPolicy).expireAt) {
+		return v.(*cachedPolicy).policy, nil
+	}
+	result, err := c.data.cc.GetCommentPolicy(ctx, &v1.GetCommentPolicyRequest{ObjType: int32(objType)})
+	if err != nil {
+		return nil, err
+	}
+	policy := &biz.CommentPolicy{
+		MaxMessageLength: int(result.MaxMessageLength),
+		MaxReplyDepth: int(result.MaxReplyDepth),
+		DefaultSort: int(result.DefaultSort),
+		AnonymousRead: result.AnonymousRead,
+		ReplyPreviewCount: int(result.ReplyPreviewCount),
+	}
+	c.policies.Store(objType, &cachedPolicy{policy: policy, expireAt: time.Now().Add(policyTTL)})
+	return policy, nil
+}
+
 
 func (c commentRepo) GetCommentSubject(ctx context.Context, subject *biz.CommentSubject) error {
 	result, err := c.data.cc.GetCommentSubject(ctx, &v1.GetCommentSubjectRequest{
@@ -34,6 +66,9 @@ func (c commentRepo) GetCommentSubject(ctx context.Context, subject *biz.Comment
 	subject.AllCount = int(result.AllCount)
 	subject.RootCount = int(result.RootCount)
 	subject.State = int8(result.State)
+	subject.Title = result.Title
+	subject.Url = result.Url
+	subject.Attributes = result.Attributes
 	subject.CreatedAt = result.CreatedAt
 	return nil
 }
@@ -52,6 +87,8 @@ func (c commentRepo) SaveComment(ctx context.Context, subject *biz.CommentSubjec
 		Device:      comment.Device,
 		Message:     comment.Message,
 		Meta:        comment.Meta,
+		Mentions:    toMentionData(comment.Mentions),
+		IdempotencyKey: comment.IdempotencyKey,
 	})
 	if err != nil {
 		return err
@@ -61,23 +98,41 @@ func (c commentRepo) SaveComment(ctx context.Context, subject *biz.CommentSubjec
 }
 
 
-func (c commentRepo) GetComment(ctx context.Context, id uint64) (*biz.Comment, error) {
-	result, err := c.data.cc.GetComment(ctx, &v1.GetCommentRequest{Id: id})
+func (c commentRepo) GetComment(ctx context.Context, id uint64, memberId uint64) (*biz.Comment, error) {
+	result, err := c.data.cc.GetComment(ctx, &v1.GetCommentRequest{Id: id, MemberId: memberId})
 	if err != nil {
 		return nil, err
 	}
 	return toBizComment(result.Comment), err
 }
 
+func (c commentRepo) GetCommentStatus(ctx context.Context, id uint64, memberId uint64) (*biz.CommentStatus, error) {
+	result, err := c.data.cc.GetCommentStatus(ctx, &v1.GetCommentStatusRequest{Id: id, MemberId: memberId})
+	if err != nil {
+		return nil, err
+	}
+	return &biz.CommentStatus{
+		Status: int8(result.Status),
+		State:  int8(result.State),
+	}, nil
+}
+
 
-func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSubject, page, size, replyCount int) ([]*biz.Comment, error) {
-	result, err := c.data.cc.ListComment(ctx, &v1.ListCommentRequest{
+func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSubject, memberId uint64, sort int, cursor string, size, replyCount int) (*biz.CommentPage, error) {
+	req := &v1.ListCommentRequest{
+		MemberId:   memberId,
 		ObjId:      subject.ObjId,
 		ObjType:    int32(subject.ObjType),
-		Page:       int32(page),
+		Cursor:     cursor,
 		Size:       int32(size),
-		ReplyCount: int32(replyCount),
-	})
+	}
+	if sort >= 0 {
+		req.Sort = proto.Int32(int32(sort))
+	}
+	if replyCount >= 0 {
+		req.ReplyCount = proto.Int32(int32(replyCount))
+	}
+	result, err := c.data.cc.ListComment(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -88,13 +143,17 @@ func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSub
 			comments[i].Replies = append(comments[i].Replies, toBizComment(result.Comments[i].Replies[j]))
 		}
 	}
-	return comments, nil
+	return &biz.CommentPage{
+		Comments:   comments,
+		NextCursor: result.NextCursor,
+		HasMore:    result.HasMore,
+	}, nil
 }
 
-func (c commentRepo) GetReplyList(ctx context.Context, rootId uint64, page, size int) ([]*biz.Comment, error) {
+func (c commentRepo) GetReplyList(ctx context.Context, rootId uint64, cursor string, size int) (*biz.CommentPage, error) {
 	result, err := c.data.cc.ListSubComment(ctx, &v1.ListSubCommentRequest{
 		RootId: rootId,
-		Page:   int32(page),
+		Cursor: cursor,
 		Size: 	int32(size),
 	})
 	if err != nil {
@@ -104,39 +163,95 @@ func (c commentRepo) GetReplyList(ctx context.Context, rootId uint64, page, size
 	for i := range result.Comments {
 		replies[i] = toBizComment(result.Comments[i])
 	}
-	return replies, nil
+	return &biz.CommentPage{
+		Comments:   replies,
+		NextCursor: result.NextCursor,
+		HasMore:    result.HasMore,
+	}, nil
 }
 
-func (c commentRepo) LikeComment(ctx context.Context, comment *biz.Comment) error {
-	_, err := c.data.cc.LikeComment(ctx, &v1.LikeCommentRequest{
+func (c commentRepo) ListCommentsByMember(ctx context.Context, memberId uint64, cursor string, size int) (*biz.CommentPage, error) {
+	result, err := c.data.cc.ListCommentsByMember(ctx, &v1.ListCommentsByMemberRequest{
+		MemberId: memberId,
+		Cursor:   cursor,
+		Size:     int32(size),
+	})
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]*biz.Comment, len(result.Comments))
+	for i := range result.Comments {
+		comments[i] = toBizComment(result.Comments[i])
+	}
+	return &biz.CommentPage{
+		Comments:   comments,
+		NextCursor: result.NextCursor,
+		HasMore:    result.HasMore,
+	}, nil
+}
+
+func (c commentRepo) ReactComment(ctx context.Context, comment *biz.Comment) error {
+	_, err := c.data.cc.ReactComment(ctx, &v1.ReactCommentRequest{
 		Id:       comment.Id,
-		Like: 	  int32(comment.Like),
 		MemberId: comment.MemberId,
+		Reaction: comment.MyReaction,
 	})
 	return err
 }
 
 
-func (c commentRepo) GetLikeItem(ctx context.Context, memberId uint64, commentIds []uint64) (map[uint64]bool, error) {
-	result, err := c.data.cc.GetCommentLiked(ctx, &v1.GetCommentLikedRequest{
-		MemberId:  memberId,
-		CommentId: commentIds,
+func (c commentRepo) ListMemberReaction(ctx context.Context, memberId uint64, commentIds []uint64) (map[uint64]string, error) {
+	result, err := c.data.cc.ListMemberReaction(ctx, &v1.ListMemberReactionRequest{
+		MemberId:   memberId,
+		CommentIds: commentIds,
 	})
 	if err != nil {
 		return nil, err
 	}
-	likedMap := make(map[uint64]bool)
-	for _, val := range result.LikedItems {
-		likedMap[val.CommentId] = val.Like
-	}
-	return likedMap, nil
+	return result.Reactions, nil
 }
 
+func (c commentRepo) UpdateComment(ctx context.Context, comment *biz.Comment) error {
+	_, err := c.data.cc.UpdateComment(ctx, &v1.UpdateCommentRequest{
+		Id:       comment.Id,
+		MemberId: comment.MemberId,
+		Message:  comment.Message,
+		Meta:     comment.Meta,
+		Mentions: toMentionData(comment.Mentions),
+	})
+	return err
+}
+
+func (c commentRepo) PinComment(ctx context.Context, comment *biz.Comment) error {
+	_, err := c.data.cc.PinComment(ctx, &v1.PinCommentRequest{
+		Id:       comment.Id,
+		MemberId: comment.MemberId,
+	})
+	return err
+}
+
+func (c commentRepo) UnpinComment(ctx context.Context, comment *biz.Comment) error {
+	_, err := c.data.cc.UnpinComment(ctx, &v1.UnpinCommentRequest{
+		Id:       comment.Id,
+		MemberId: comment.MemberId,
+	})
+	return err
+}
+
+func (c commentRepo) DeleteComment(ctx context.Context, comment *biz.Comment) error {
+	_, err := c.data.cc.DeleteComment(ctx, &v1.DeleteCommentRequest{
+		Id:       comment.Id,
+		MemberId: comment.MemberId,
+	})
+	return err
+}
 
 
 func toBizComment(ci *v1.CommentData) *biz.Comment {
-	return &biz.Comment{
+	comment := &biz.Comment{
 		Id:          ci.Id,
+		ObjId:       ci.ObjId,
+		ObjType:     int(ci.ObjType),
 		MemberId:    ci.MemberId,
 		Root:        ci.Root,
 		Parent:      ci.Parent,
@@ -153,8 +268,36 @@ func toBizComment(ci *v1.CommentData) *biz.Comment {
 		Device:      ci.Device,
 		Message:     ci.Message,
 		Meta:        ci.Meta,
+		Edited:      ci.Edited,
+		EditedAt:    ci.EditedAt,
+		Pinned:      ci.Pinned,
+		Reactions:   make(map[string]int, len(ci.Reactions)),
 		CreatedAt:   ci.CreateAt,
 		UpdatedAt:   ci.UpdatedAt,
+		Queued:      ci.Queued,
 		Replies:     make([]*biz.Comment, 0),
 	}
+	for reaction, count := range ci.Reactions {
+		comment.Reactions[reaction] = int(count)
+	}
+	for _, mention := range ci.Mentions {
+		comment.Mentions = append(comment.Mentions, biz.Mention{
+			MemberId: mention.MemberId,
+			Start:    int(mention.Start),
+			End:      int(mention.End),
+		})
+	}
+	return comment
+}
+
+func toMentionData(mentions []biz.Mention) []*v1.Mention {
+	ret := make([]*v1.Mention, len(mentions))
+	for i, mention := range mentions {
+		ret[i] = &v1.Mention{
+			MemberId: mention.MemberId,
+			Start:    int32(mention.Start),
+			End:      int32(mention.End),
+		}
+	}
+	return ret
 }
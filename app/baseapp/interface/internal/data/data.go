@@ -22,6 +22,7 @@ var ProviderSet = wire.NewSet(
 	NewAccountServiceClient,
 	NewCommentRepo,
 	NewAccountRepo,
+	NewNotificationRepo,
 )
 
 // Data .
@@ -0,0 +1,65 @@
+package data
+
+import (
+	v1 "base-service/api/comment/service/v1"
+	"base-service/app/baseapp/interface/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type notificationRepo struct {
+	log *log.Helper
+	data *Data
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		log:  log.NewHelper(logger),
+		data: data,
+	}
+}
+
+func (n notificationRepo) ListNotification(ctx context.Context, memberId uint64, page, size int) ([]*biz.Notification, error) {
+	result, err := n.data.cc.ListNotification(ctx, &v1.ListNotificationRequest{
+		MemberId: memberId,
+		Page:     int32(page),
+		Size:     int32(size),
+	})
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]*biz.Notification, len(result.Notifications))
+	for i, item := range result.Notifications {
+		notifications[i] = &biz.Notification{
+			Id:         item.Id,
+			Type:       int8(item.Type),
+			CommentId:  item.CommentId,
+			ObjId:      item.ObjId,
+			ObjType:    int(item.ObjType),
+			ActorId:    item.ActorId,
+			ActorCount: int(item.ActorCount),
+			Read:       item.Read,
+			CreatedAt:  item.CreatedAt,
+			UpdatedAt:  item.UpdatedAt,
+		}
+	}
+	return notifications, nil
+}
+
+func (n notificationRepo) ReadNotification(ctx context.Context, memberId uint64, ids []uint64) error {
+	_, err := n.data.cc.ReadNotification(ctx, &v1.ReadNotificationRequest{
+		MemberId: memberId,
+		Ids:      ids,
+	})
+	return err
+}
+
+func (n notificationRepo) GetUnreadNotificationCount(ctx context.Context, memberId uint64) (int, error) {
+	result, err := n.data.cc.GetUnreadNotificationCount(ctx, &v1.GetUnreadNotificationCountRequest{
+		MemberId: memberId,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(result.Count), nil
+}
@@ -6,6 +6,7 @@ import (
 	"base-service/app/baseapp/interface/internal/pkg/resp"
 	"base-service/app/baseapp/interface/internal/service"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	mmd "github.com/go-kratos/kratos/v2/middleware/metadata"
@@ -35,8 +36,9 @@ func NewHTTPServer(c *conf.Server, baseapp *service.BaseappInterfaceService, log
 		),
 		http.Filter(handlers.CORS(
 			handlers.AllowedOrigins([]string{"*"}),
-			handlers.AllowedHeaders([]string{"Content-Type", "AuthToken"}),
-			handlers.AllowedMethods([]string{"GET", "POST", "DELETE", "OPTIONS"}),
+			handlers.AllowedHeaders([]string{"Content-Type", "AuthToken", "Idempotency-Key"}),
+			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
+			handlers.ExposedHeaders([]string{"Retry-After"}),
 		)),
 	}
 	if c.Http.Network != "" {
@@ -49,6 +51,7 @@ func NewHTTPServer(c *conf.Server, baseapp *service.BaseappInterfaceService, log
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	opts = append(opts, http.ResponseEncoder(PrimaryResponseEncoder))
+	opts = append(opts, http.ErrorEncoder(ErrorEncoder))
 	srv := http.NewServer(opts...)
 	v1.RegisterBaseappInterfaceHTTPServer(srv, baseapp)
 	return srv
@@ -78,4 +81,13 @@ func PrimaryResponseEncoder(w nHttp.ResponseWriter, r *nHttp.Request, v interfac
 	}
 	_, _ = w.Write(data)
 	return nil
-}
\ No newline at end of file
+}
+
+// ErrorEncoder 错误带有 retry_after 时同时设置 Retry-After 头, 其余与默认的错误编码一致
+func ErrorEncoder(w nHttp.ResponseWriter, r *nHttp.Request, err error) {
+	se := errors.FromError(err)
+	if retryAfter := se.Metadata["retry_after"]; retryAfter != "" {
+		w.Header().Set("Retry-After", retryAfter)
+	}
+	http.DefaultErrorEncoder(w, r, err)
+}
@@ -1,10 +1,18 @@
 package service
 
 import (
+	commentV1 "base-service/api/comment/service/v1"
 	"base-service/app/baseapp/interface/internal/biz"
+	"base-service/app/baseapp/interface/internal/conf"
 	"base-service/app/baseapp/interface/internal/pkg/token"
 	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"net"
+	"strings"
 
 	pb "base-service/api/baseapp/interface/v1"
 )
@@ -13,25 +21,67 @@ type BaseappInterfaceService struct {
 	pb.UnimplementedBaseappInterfaceServer
 	uc *biz.CommentUsecase
 	accountUC *biz.AccountUsecase
+	notificationUC *biz.NotificationUsecase
+	trustedProxies []*net.IPNet
 	log *log.Helper
 }
 
 func NewBaseappInterfaceService(
+		c *conf.Server,
 		uc *biz.CommentUsecase,
 		accountUC *biz.AccountUsecase,
-		logger log.Logger) *BaseappInterfaceService {
+		notificationUC *biz.NotificationUsecase,
+		logger log.Logger) (*BaseappInterfaceService, error) {
+	trustedProxies, err := parseTrustedProxies(c.GetHttp().GetTrustedProxies())
+	if err != nil {
+		return nil, err
+	}
 	return &BaseappInterfaceService{
 		uc: uc,
 		accountUC: accountUC,
+		notificationUC: notificationUC,
+		trustedProxies: trustedProxies,
 		log: log.NewHelper(logger),
+	}, nil
+}
+
+// parseTrustedProxies 解析可信网关地址, 单个 ip 视为只包含该地址的网段
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8 * net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		nets = append(nets, ipNet)
 	}
+	return nets, nil
 }
 func (s *BaseappInterfaceService) GetCommentSubject(ctx context.Context, req *pb.GetCommentSubjectRequest) (*pb.GetCommentSubjectReply, error) {
 	if req.ObjId <= 0 || req.ObjType <= 0{
 		return nil, pb.ErrorContentMissing("invalid params")
 	}
+	if err := s.checkRead(ctx, int(req.ObjType)); err != nil {
+		return nil, err
+	}
+	policy, err := s.uc.GetCommentPolicy(ctx, int(req.ObjType))
+	if err != nil {
+		return nil, err
+	}
 	subject := &biz.CommentSubject{ObjId: req.ObjId, ObjType: int(req.ObjType)}
-	err := s.uc.GetCommentSubject(ctx, subject)
+	err = s.uc.GetCommentSubject(ctx, subject)
 	return &pb.GetCommentSubjectReply{
 		Id:        subject.Id,
 		ObjId:     subject.ObjId,
@@ -42,9 +92,27 @@ func (s *BaseappInterfaceService) GetCommentSubject(ctx context.Context, req *pb
 		AllCount:  int32(subject.AllCount),
 		State: 	   int32(subject.State),
 		CreatedAt: subject.CreatedAt,
+		Commentable: subject.State == biz.SubjectStateOpen,
+		Title:       subject.Title,
+		Url:         subject.Url,
+		Attributes:  subject.Attributes,
+		MaxMessageLength: int32(policy.MaxMessageLength),
+		MaxReplyDepth:    int32(policy.MaxReplyDepth),
 	}, err
 }
 
+// checkRead 未登录用户查看不允许匿名查看的 obj_type 的评论时返回 UNAUTHORIZED
+func (s *BaseappInterfaceService) checkRead(ctx context.Context, objType int) error {
+	ok, err := s.uc.CanRead(ctx, objType)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return pb.ErrorUNAUTHORIZED("login required to read comments of obj_type %d", objType)
+	}
+	return nil
+}
+
 
 func (s *BaseappInterfaceService) SaveComment(ctx context.Context, req *pb.SaveCommentRequest) (*pb.SaveCommentReply, error) {
 	uid, err := token.ExtractUid(ctx)
@@ -61,13 +129,36 @@ func (s *BaseappInterfaceService) SaveComment(ctx context.Context, req *pb.SaveC
 		Parent:      req.Parent,
 		State:       0,
 		AtMemberIds: "",
-		Ip:          "",
+		Ip:          s.clientIp(ctx),
 		Platform: 	 0,
 		Device:      "unknown",
 		Message:     req.Content,
 		Meta:        req.Meta,
+		IdempotencyKey: requestHeader(ctx, "Idempotency-Key"),
 	}
 	err = s.uc.SaveComment(ctx, subject, comment)
+	if commentV1.IsCommentServiceErrorReasonSubjectClosed(err) {
+		return nil, pb.ErrorSubjectClosed("comments on %d are closed", req.ObjId)
+	}
+	if commentV1.IsCommentServiceErrorReasonInvalidIdempotencyKey(err) {
+		return nil, pb.ErrorInvalidArgument("Idempotency-Key is longer than 64")
+	}
+	if commentV1.IsCommentServiceErrorReasonIdempotencyKeyReused(err) {
+		return nil, pb.ErrorIdempotencyKeyReused("Idempotency-Key is used by another request")
+	}
+	if commentV1.IsCommentServiceErrorReasonRequestInProgress(err) {
+		return nil, pb.ErrorRequestInProgress("request with the same Idempotency-Key is in progress, retry later")
+	}
+	if commentV1.IsCommentServiceErrorReasonMessageTooLong(err) {
+		return nil, pb.ErrorInvalidArgument("content is too long")
+	}
+	if commentV1.IsCommentServiceErrorReasonReplyNotAllowed(err) {
+		return nil, pb.ErrorInvalidArgument("can not reply to comment %d", req.Parent)
+	}
+	if commentV1.IsCommentServiceErrorReasonRateLimited(err) {
+		// 透传评论服务给出的 retry_after
+		return nil, pb.ErrorRateLimited("comment too frequently").WithMetadata(errors.FromError(err).Metadata)
+	}
 	return &pb.SaveCommentReply{
 		Id: comment.Id,
 	}, err
@@ -78,18 +169,54 @@ func (s *BaseappInterfaceService) GetComment(ctx context.Context, req *pb.GetCom
 	if err != nil {
 		return nil, err
 	}
+	if err = s.checkRead(ctx, result.ObjType); err != nil {
+		return nil, err
+	}
 	return &pb.GetCommentReply{Comment: createCommentData(result)}, nil
 }
 
+func (s *BaseappInterfaceService) GetCommentStatus(ctx context.Context, req *pb.GetCommentStatusRequest) (*pb.GetCommentStatusReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	result, err := s.uc.GetCommentStatus(ctx, req.Id, uid)
+	if err != nil {
+		if commentV1.IsCommentServiceErrorReasonCommentNotFound(err) {
+			return nil, pb.ErrorInfoNotFound("comment %d not found", req.Id)
+		}
+		return nil, err
+	}
+	return &pb.GetCommentStatusReply{
+		Status: int32(result.Status),
+		State:  int32(result.State),
+	}, nil
+}
+
 func (s *BaseappInterfaceService) GetCommentList(ctx context.Context, req *pb.GetCommentListRequest) (*pb.GetCommentListReply, error) {
-	list, err := s.uc.GetCommentList(ctx, &biz.CommentSubject{
+	if err := s.checkRead(ctx, int(req.ObjType)); err != nil {
+		return nil, err
+	}
+	// 未指定时使用 obj_type 的默认值
+	sort, reply := -1, -1
+	if req.Sort != nil {
+		sort = int(req.GetSort())
+	}
+	if req.Reply != nil {
+		reply = int(req.GetReply())
+	}
+	page, err := s.uc.GetCommentList(ctx, &biz.CommentSubject{
 		ObjId:   req.ObjId,
 		ObjType: int(req.ObjType),
-	}, int(req.Page), int(req.Size), int(req.Reply))
+	}, sort, req.Cursor, int(req.Size), reply)
 	if err != nil {
+		if commentV1.IsCommentServiceErrorReasonInvalidCursor(err) {
+			return nil, pb.ErrorInvalidArgument("invalid cursor %s", req.Cursor)
+		}
 		s.log.Errorf("rpc get comment list failed: %v", err)
 		return nil, pb.ErrorInfoNotFound("comment not found, obj_id: %d", req.ObjId)
 	}
+	list := page.Comments
 	comments := make([]*pb.CommentData, len(list))
 	for i := range list {
 		comments[i] = createCommentData(list[i])
@@ -99,37 +226,241 @@ func (s *BaseappInterfaceService) GetCommentList(ctx context.Context, req *pb.Ge
 	}
 
 	return &pb.GetCommentListReply{
-		Comments: comments,
+		Comments:   comments,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
 	}, nil
 }
 
 func (s *BaseappInterfaceService) GetReplyList(ctx context.Context, req *pb.GetReplyListRequest) (*pb.GetCommentListReply, error) {
-	list, err := s.uc.GetReplyList(ctx, req.RootId, int(req.Page), int(req.Size))
+	page, err := s.uc.GetReplyList(ctx, req.RootId, req.Cursor, int(req.Size))
 	if err != nil {
+		if commentV1.IsCommentServiceErrorReasonInvalidCursor(err) {
+			return nil, pb.ErrorInvalidArgument("invalid cursor %s", req.Cursor)
+		}
 		return nil, pb.ErrorInfoNotFound("comment reply for %d not found", req.RootId)
 	}
+	list := page.Comments
+	if len(list) > 0 {
+		if err = s.checkRead(ctx, list[0].ObjType); err != nil {
+			return nil, err
+		}
+	}
 	replies := make([]*pb.CommentData, len(list))
 	for i := range list {
 		replies[i] = createCommentData(list[i])
 	}
 	return &pb.GetCommentListReply{
-		Comments: replies,
+		Comments:   replies,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+func (s *BaseappInterfaceService) ListMyComment(ctx context.Context, req *pb.ListMyCommentRequest) (*pb.GetCommentListReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	page, err := s.uc.ListMyComment(ctx, uid, req.Cursor, int(req.Size))
+	if err != nil {
+		if commentV1.IsCommentServiceErrorReasonInvalidCursor(err) {
+			return nil, pb.ErrorInvalidArgument("invalid cursor %s", req.Cursor)
+		}
+		return nil, err
+	}
+	list := page.Comments
+	comments := make([]*pb.CommentData, len(list))
+	for i := range list {
+		comments[i] = createCommentData(list[i])
+	}
+	return &pb.GetCommentListReply{
+		Comments:   comments,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
 	}, nil
 }
 
-func (s *BaseappInterfaceService) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentReply, error) {
+func (s *BaseappInterfaceService) ReactComment(ctx context.Context, req *pb.ReactCommentRequest) (*pb.ReactCommentReply, error) {
 	uid, err := token.ExtractUid(ctx)
 	if err != nil {
 		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
 	}
-	err = s.uc.LikeComment(ctx, &biz.Comment{
+	err = s.uc.ReactComment(ctx, &biz.Comment{
+		Id:         req.Id,
+		MemberId:   uid,
+		MyReaction: req.Reaction,
+	})
+	if err != nil {
+		switch {
+		case commentV1.IsCommentServiceErrorReasonCommentNotFound(err):
+			return nil, pb.ErrorInfoNotFound("comment %d not found", req.Id)
+		case commentV1.IsCommentServiceErrorReasonInvalidReaction(err):
+			return nil, pb.ErrorInvalidArgument("unsupported reaction %s", req.Reaction)
+		case commentV1.IsCommentServiceErrorReasonInvalidState(err):
+			return nil, pb.ErrorInvalidArgument("comment %d can not be reacted", req.Id)
+		case commentV1.IsCommentServiceErrorReasonSubjectClosed(err):
+			return nil, pb.ErrorSubjectClosed("subject of comment %d is locked", req.Id)
+		}
+		return nil, err
+	}
+	return &pb.ReactCommentReply{}, nil
+}
+
+func (s *BaseappInterfaceService) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.UpdateCommentReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	if req.Content == "" {
+		return nil, pb.ErrorContentMissing("content is empty")
+	}
+	err = s.uc.UpdateComment(ctx, &biz.Comment{
+		Id:       req.Id,
+		MemberId: uid,
+		Message:  req.Content,
+		Meta:     req.Meta,
+	})
+	if err != nil {
+		return nil, updateCommentError(err, req.Id)
+	}
+	return &pb.UpdateCommentReply{}, nil
+}
+
+func updateCommentError(err error, id uint64) error {
+	switch {
+	case commentV1.IsCommentServiceErrorReasonCommentNotFound(err):
+		return pb.ErrorInfoNotFound("comment %d not found", id)
+	case commentV1.IsCommentServiceErrorReasonPermissionDenied(err):
+		return pb.ErrorUNAUTHORIZED("only the author can edit comment %d", id)
+	case commentV1.IsCommentServiceErrorReasonEditWindowExpired(err):
+		return pb.ErrorEditWindowExpired("comment %d can no longer be edited", id)
+	case commentV1.IsCommentServiceErrorReasonInvalidState(err):
+		return pb.ErrorInvalidState("comment %d can not be edited in its current state", id)
+	case commentV1.IsCommentServiceErrorReasonSubjectClosed(err):
+		return pb.ErrorSubjectClosed("subject of comment %d is locked", id)
+	case commentV1.IsCommentServiceErrorReasonMessageTooLong(err):
+		return pb.ErrorInvalidArgument("content is too long")
+	}
+	return err
+}
+
+func (s *BaseappInterfaceService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	err = s.uc.DeleteComment(ctx, &biz.Comment{
+		Id:       req.Id,
+		MemberId: uid,
+	})
+	if err != nil {
+		if commentV1.IsCommentServiceErrorReasonCommentNotFound(err) {
+			return nil, pb.ErrorInfoNotFound("comment %d not found", req.Id)
+		}
+		if commentV1.IsCommentServiceErrorReasonPermissionDenied(err) {
+			return nil, pb.ErrorUNAUTHORIZED("only the author can delete comment %d", req.Id)
+		}
+		if commentV1.IsCommentServiceErrorReasonSubjectClosed(err) {
+			return nil, pb.ErrorSubjectClosed("subject of comment %d is locked", req.Id)
+		}
+		return nil, err
+	}
+	return &pb.DeleteCommentReply{}, nil
+}
+
+func (s *BaseappInterfaceService) PinComment(ctx context.Context, req *pb.PinCommentRequest) (*pb.PinCommentReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	err = s.uc.PinComment(ctx, &biz.Comment{
 		Id:       req.Id,
 		MemberId: uid,
-		Like: 	  int(req.Like),
 	})
-	return &pb.LikeCommentReply{}, err
+	if err != nil {
+		return nil, pinCommentError(err, req.Id)
+	}
+	return &pb.PinCommentReply{}, nil
+}
+
+func (s *BaseappInterfaceService) UnpinComment(ctx context.Context, req *pb.UnpinCommentRequest) (*pb.UnpinCommentReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	err = s.uc.UnpinComment(ctx, &biz.Comment{
+		Id:       req.Id,
+		MemberId: uid,
+	})
+	if err != nil {
+		return nil, pinCommentError(err, req.Id)
+	}
+	return &pb.UnpinCommentReply{}, nil
+}
+
+func pinCommentError(err error, id uint64) error {
+	switch {
+	case commentV1.IsCommentServiceErrorReasonCommentNotFound(err):
+		return pb.ErrorInfoNotFound("comment %d not found", id)
+	case commentV1.IsCommentServiceErrorReasonPermissionDenied(err):
+		return pb.ErrorUNAUTHORIZED("only the subject owner can pin comment %d", id)
+	case commentV1.IsCommentServiceErrorReasonInvalidState(err):
+		return pb.ErrorInvalidArgument("comment %d can not be pinned", id)
+	case commentV1.IsCommentServiceErrorReasonPinLimitExceeded(err):
+		return pb.ErrorInvalidArgument("too many pinned comments")
+	case commentV1.IsCommentServiceErrorReasonSubjectClosed(err):
+		return pb.ErrorSubjectClosed("subject of comment %d is locked", id)
+	}
+	return err
+}
+
+// requestHeader 读取 http 请求头, grpc 请求返回空
+func requestHeader(ctx context.Context, key string) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	ht, ok := tr.(*http.Transport)
+	if !ok {
+		return ""
+	}
+	return ht.RequestHeader().Get(key)
 }
 
+// clientIp 请求方的 ip, 请求来自可信网关时取网关设置的 X-Real-IP
+func (s *BaseappInterfaceService) clientIp(ctx context.Context) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	ht, ok := tr.(*http.Transport)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(ht.Request().RemoteAddr)
+	if err != nil {
+		host = ht.Request().RemoteAddr
+	}
+	if realIp := ht.RequestHeader().Get("X-Real-IP"); realIp != "" && s.isTrustedProxy(host) {
+		return realIp
+	}
+	return host
+}
+
+// isTrustedProxy 地址是否为可信网关
+func (s *BaseappInterfaceService) isTrustedProxy(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range s.trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
 
 func (s *BaseappInterfaceService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginReply, error) {
 	account, tokenStr, err := s.accountUC.Login(ctx, req.Account, req.Password)
@@ -148,7 +479,7 @@ func (s *BaseappInterfaceService) Login(ctx context.Context, req *pb.LoginReques
 
 
 func createCommentData(comment *biz.Comment) *pb.CommentData {
-	return &pb.CommentData{
+	data := &pb.CommentData{
 		Id:          comment.Id,
 		MemberId:    comment.MemberId,
 		Nickname: 	 comment.Nickname,
@@ -171,8 +502,28 @@ func createCommentData(comment *biz.Comment) *pb.CommentData {
 		Device:      comment.Device,
 		Message:     comment.Message,
 		Meta:        comment.Meta,
+		Edited:      comment.Edited,
+		EditedAt:    comment.EditedAt,
+		Pinned:      comment.Pinned,
+		Reactions:   make(map[string]int32, len(comment.Reactions)),
+		MyReaction:  comment.MyReaction,
 		CreateAt:    comment.CreatedAt,
 		UpdatedAt:   comment.UpdatedAt,
 		Replies:     make([]*pb.CommentData, 0),
+		Mentions:    make([]*pb.Mention, len(comment.Mentions)),
+		ObjId:       comment.ObjId,
+		ObjType:     int32(comment.ObjType),
+		Queued:      comment.Queued,
+	}
+	for reaction, count := range comment.Reactions {
+		data.Reactions[reaction] = int32(count)
+	}
+	for i, mention := range comment.Mentions {
+		data.Mentions[i] = &pb.Mention{
+			MemberId: mention.MemberId,
+			Start:    int32(mention.Start),
+			End:      int32(mention.End),
+		}
 	}
+	return data
 }
\ No newline at end of file
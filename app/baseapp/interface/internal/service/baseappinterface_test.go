@@ -0,0 +1,73 @@
+package service
+
+import (
+	commentV1 "base-service/api/comment/service/v1"
+	"errors"
+	"testing"
+
+	pb "base-service/api/baseapp/interface/v1"
+)
+
+func TestTrustedProxies(t *testing.T) {
+	trustedProxies, err := parseTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8", "::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &BaseappInterfaceService{trustedProxies: trustedProxies}
+	tests := map[string]bool{
+		"127.0.0.1": true,
+		"127.0.0.2": false,
+		"10.1.2.3": true,
+		"11.0.0.1": false,
+		"::1": true,
+		"::2": false,
+		"": false,
+		"not an ip": false,
+	}
+	for host, want := range tests {
+		if got := s.isTrustedProxy(host); got != want {
+			t.Errorf("isTrustedProxy(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		proxies []string
+		wantErr bool
+	}{
+		{nil, false},
+		{[]string{"192.168.0.1", "192.168.0.0/16", "fe80::/10"}, false},
+		{[]string{"192.168.0.256"}, true},
+		{[]string{"192.168.0.0/33"}, true},
+		{[]string{"gateway"}, true},
+	}
+	for _, tt := range tests {
+		_, err := parseTrustedProxies(tt.proxies)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseTrustedProxies(%v) err = %v, want err %v", tt.proxies, err, tt.wantErr)
+		}
+	}
+}
+
+func TestUpdateCommentError(t *testing.T) {
+	other := errors.New("unavailable")
+	tests := []struct {
+		name string
+		err error
+		want func(error) bool
+	}{
+		{"not found", commentV1.ErrorCommentServiceErrorReasonCommentNotFound(""), pb.IsInfoNotFound},
+		{"not the author", commentV1.ErrorCommentServiceErrorReasonPermissionDenied(""), pb.IsUNAUTHORIZED},
+		{"edit window expired", commentV1.ErrorCommentServiceErrorReasonEditWindowExpired(""), pb.IsEditWindowExpired},
+		{"invalid state", commentV1.ErrorCommentServiceErrorReasonInvalidState(""), pb.IsInvalidState},
+		{"subject locked", commentV1.ErrorCommentServiceErrorReasonSubjectClosed(""), pb.IsSubjectClosed},
+		{"too long", commentV1.ErrorCommentServiceErrorReasonMessageTooLong(""), pb.IsInvalidArgument},
+		{"other", other, func(err error) bool { return err == other }},
+	}
+	for _, tt := range tests {
+		if got := updateCommentError(tt.err, 1); !tt.want(got) {
+			t.Errorf("%s: mapped to %v", tt.name, got)
+		}
+	}
+}
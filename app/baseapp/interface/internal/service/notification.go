@@ -0,0 +1,60 @@
+package service
+
+import (
+	"base-service/app/baseapp/interface/internal/pkg/token"
+	"context"
+
+	pb "base-service/api/baseapp/interface/v1"
+)
+
+func (s *BaseappInterfaceService) ListNotification(ctx context.Context, req *pb.ListNotificationRequest) (*pb.ListNotificationReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	notifications, err := s.notificationUC.ListNotification(ctx, uid, int(req.Page), int(req.Size))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.NotificationData, len(notifications))
+	for i, item := range notifications {
+		result[i] = &pb.NotificationData{
+			Id:            item.Id,
+			Type:          int32(item.Type),
+			CommentId:     item.CommentId,
+			ObjId:         item.ObjId,
+			ObjType:       int32(item.ObjType),
+			ActorId:       item.ActorId,
+			ActorNickname: item.ActorNickname,
+			ActorAvatar:   item.ActorAvatar,
+			ActorCount:    int32(item.ActorCount),
+			Read:          item.Read,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+		}
+	}
+	return &pb.ListNotificationReply{Notifications: result}, nil
+}
+
+func (s *BaseappInterfaceService) ReadNotification(ctx context.Context, req *pb.ReadNotificationRequest) (*pb.ReadNotificationReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	if err = s.notificationUC.ReadNotification(ctx, uid, req.Ids); err != nil {
+		return nil, err
+	}
+	return &pb.ReadNotificationReply{}, nil
+}
+
+func (s *BaseappInterfaceService) GetUnreadNotificationCount(ctx context.Context, req *pb.GetUnreadNotificationCountRequest) (*pb.GetUnreadNotificationCountReply, error) {
+	uid, err := token.ExtractUid(ctx)
+	if err != nil {
+		return nil, pb.ErrorUNAUTHORIZED("unauthorized")
+	}
+	count, err := s.notificationUC.GetUnreadNotificationCount(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUnreadNotificationCountReply{Count: int32(count)}, nil
+}
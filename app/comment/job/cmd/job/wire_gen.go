@@ -24,12 +24,30 @@ func initApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 		return nil, nil, err
 	}
 	commentRepo := data.NewCommentRepo(dataData, logger)
-	commentUsecase := biz.NewCommentUsecase(commentRepo, logger)
-	commentJobService := service.NewCommentJobService(commentUsecase, logger, dataData)
+	filter, cleanup2, err := data.NewWordFilter(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	commentUsecase := biz.NewCommentUsecase(commentRepo, filter, confData, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, commentRepo, logger)
+	deadLetterRepo := data.NewDeadLetterRepo(dataData, logger)
+	deadLetterUsecase := biz.NewDeadLetterUsecase(deadLetterRepo, logger)
+	reconcileRepo := data.NewReconcileRepo(dataData, logger)
+	reconcileUsecase, cleanup3, err := biz.NewReconcileUsecase(reconcileRepo, confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	commentJobService := service.NewCommentJobService(commentUsecase, notificationUsecase, deadLetterUsecase, reconcileUsecase, logger, dataData)
 	httpServer := server.NewHTTPServer(confServer, commentJobService, logger)
 	grpcServer := server.NewGRPCServer(confServer, commentJobService, logger)
 	app := newApp(logger, httpServer, grpcServer)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
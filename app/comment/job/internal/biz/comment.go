@@ -1,11 +1,75 @@
 package biz
 
 import (
+	"base-service/app/comment/job/internal/conf"
+	"base-service/app/comment/job/internal/pkg/wordfilter"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
+	"math"
 	"time"
 )
 
+// 评论状态, 与评论服务保持一致
+const (
+	CommentStatePublished int8 = iota // 已发布
+	CommentStatePending               // 待审核
+	CommentStateHidden                // 已隐藏
+	CommentStateRejected              // 审核未通过
+	CommentStateAdminDeleted          // 管理员删除
+)
+
+// CommentVisibleStates 列表中可见的评论状态, 主题与根评论的计数只统计这些状态
+var CommentVisibleStates = []int8{CommentStatePublished}
+
+// IsCommentVisible 评论是否对外可见
+func IsCommentVisible(state int8) bool {
+	for _, s := range CommentVisibleStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// 点赞与点踩, 与评论服务保持一致
+const (
+	ReactionLike = "like"
+	ReactionDislike = "dislike"
+)
+
+// 根评论排序方式, 与评论服务保持一致
+const (
+	CommentSortFloor = iota // 按楼层倒序
+	CommentSortHot          // 按热度
+	CommentSortTop          // 按点赞数
+)
+
+// 评论命中敏感词时的处理方式, 与配置中的 Filter.Policy 一致
+const (
+	FilterPolicyMask int8 = iota // 打码后发布
+	FilterPolicyReject           // 直接拒绝
+	FilterPolicyReview           // 转人工审核
+)
+
+const (
+	hotScoreEpoch int64 = 1609459200 // 2021-01-01 00:00:00 UTC
+	hotScoreDecay = 45000 // 发布时间每晚 45000 秒, 需要多10倍的互动量才能排在同一位置
+)
+
+// HotScore 热度分数, 互动量取对数后叠加发布时间, 旧评论的热度随时间自然衰减, 分数不需要定时重算
+func HotScore(like int, replies int, createdAt time.Time) float64 {
+	weight := like + 2 * replies
+	if weight < 1 {
+		weight = 1
+	}
+	return math.Log10(float64(weight)) + float64(createdAt.Unix() - hotScoreEpoch) / hotScoreDecay
+}
+
+// TopScore 点赞数分数, 点赞数相同时较新的评论在前
+func TopScore(like int, createdAt time.Time) float64 {
+	return float64(like) * (1 << 32) + float64(createdAt.Unix())
+}
+
 // CommentSubject 评论主题对象
 type CommentSubject struct {
 	Id uint64
@@ -23,6 +87,8 @@ type CommentSubject struct {
 // Comment 评论本身
 type Comment struct {
 	Id uint64
+	ObjId uint64 // 所属主题对象, 仅查询已保存的评论时填充
+	ObjType int
 	MemberId uint64
 	Root uint64
 	Parent uint64
@@ -31,7 +97,6 @@ type Comment struct {
 	Count int
 	RootCount int
 	Like int
-	Liked bool
 	Hate int
 	State int8
 	AtMemberIds string
@@ -43,36 +108,62 @@ type Comment struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Replies []*Comment
+	FilterPolicy int8 // 命中敏感词时采用的处理方式
+	FilterHits []wordfilter.Hit // 命中的敏感词, 原文中的位置
+	Mentions []Mention
+	IdempotencyKey string // 客户端请求id, 同一成员相同的请求id只保存一条评论
+	IdempotencyFingerprint string // 请求内容的摘要
 }
 
-
-type LikeItem struct {
-	CommentId uint64
-	Like bool
+// Mention 评论中 @ 的成员, 与评论服务保持一致
+type Mention struct {
+	MemberId uint64 `json:"member_id"`
+	Start int `json:"start"`
+	End int `json:"end"`
 }
 
+// CommentIndexCache 需要构建缓存的一页根评论, Floor 为游标楼层, 0 表示第一页
 type CommentIndexCache struct {
 	ObjId uint64
 	ObjType int
-	Page int
+	Floor int
 	Size int
 }
 
 type CommentRepo interface {
 	CreateSubject(ctx context.Context, subject *CommentSubject) error
 	SaveComment(ctx context.Context, subject *CommentSubject, comment *Comment) error
-	UpdateLikeNum(ctx context.Context, comment *Comment) error
 	BuildCommentIndexCache(ctx context.Context, param CommentIndexCache) error
+	UpdateCommentScore(ctx context.Context, id uint64) error
+	// RefreshCommentIndexCache 刷新 ci 缓存中评论所属的根评论
+	RefreshCommentIndexCache(ctx context.Context, id uint64) error
+	BuildCommentScoreCache(ctx context.Context, objId uint64, objType int) error
+	// GetComment 查询已保存的评论, 不包含内容, 不存在时返回 nil
+	GetComment(ctx context.Context, id uint64) (*Comment, error)
+	// GetCommentContent 查询已保存的评论及其内容, 不存在时返回 nil
+	GetCommentContent(ctx context.Context, id uint64) (*Comment, error)
+	// UpdateFilteredComment 保存重新过滤的结果, 评论状态或内容已不是过滤时的 state 与 message 时跳过
+	UpdateFilteredComment(ctx context.Context, comment *Comment, state int8, message string) error
 }
 
 type CommentUsecase struct {
 	repo CommentRepo
+	filter *wordfilter.Filter
+	defaultPolicy int8
+	policies map[int]int8
 	log *log.Helper
 }
 
-func NewCommentUsecase(repo CommentRepo, logger log.Logger) *CommentUsecase {
+func NewCommentUsecase(repo CommentRepo, filter *wordfilter.Filter, c *conf.Data, logger log.Logger) *CommentUsecase {
+	policies := make(map[int]int8)
+	for objType, policy := range c.GetFilter().GetPolicies() {
+		policies[int(objType)] = int8(policy)
+	}
 	return &CommentUsecase{
 		repo: repo,
+		filter: filter,
+		defaultPolicy: int8(c.GetFilter().GetDefaultPolicy()),
+		policies: policies,
 		log: log.NewHelper(logger),
 	}
 }
@@ -81,21 +172,76 @@ func (uc *CommentUsecase) CreateSubject(ctx context.Context, subject *CommentSub
 	return uc.repo.CreateSubject(ctx, subject)
 }
 
-// CreateComment 创建一条评论
+// CreateComment 创建一条评论, 保存前过滤敏感词, 保存时写入评论创建事件
 func (uc *CommentUsecase) CreateComment(ctx context.Context, subject *CommentSubject, comment *Comment) error {
+	uc.filterComment(subject, comment)
 	return uc.repo.SaveComment(ctx, subject, comment)
 }
 
+// RefreshComment 评论创建、删除或表态变化后刷新列表缓存与排序分数, 重复调用结果相同
+func (uc *CommentUsecase) RefreshComment(ctx context.Context, id uint64) error {
+	if err := uc.repo.RefreshCommentIndexCache(ctx, id); err != nil {
+		return err
+	}
+	return uc.repo.UpdateCommentScore(ctx, id)
+}
+
+// filterComment 检查评论内容中的敏感词, 按 obj_type 的策略打码、拒绝或转人工审核
+// FilterEditedComment 评论修改后重新过滤敏感词, 处理方式与发表时一致
+func (uc *CommentUsecase) FilterEditedComment(ctx context.Context, id uint64) error {
+	comment, err := uc.repo.GetCommentContent(ctx, id)
+	if err != nil {
+		return err
+	}
+	if comment == nil {
+		return nil
+	}
+	state, message := comment.State, comment.Message
+	uc.filterComment(&CommentSubject{ObjId: comment.ObjId, ObjType: comment.ObjType}, comment)
+	if len(comment.FilterHits) == 0 {
+		return nil
+	}
+	// 仅待审核与已发布的评论可以被拒绝
+	if comment.State == CommentStateRejected && state != CommentStatePublished && state != CommentStatePending {
+		comment.State = state
+	}
+	return uc.repo.UpdateFilteredComment(ctx, comment, state, message)
+}
+
+func (uc *CommentUsecase) filterComment(subject *CommentSubject, comment *Comment) {
+	hits := uc.filter.Match(comment.Message)
+	if len(hits) == 0 {
+		return
+	}
+	policy, ok := uc.policies[subject.ObjType]
+	if !ok {
+		policy = uc.defaultPolicy
+	}
+	comment.FilterPolicy = policy
+	comment.FilterHits = hits
+	switch policy {
+	case FilterPolicyMask:
+		comment.Message = wordfilter.Mask(comment.Message, hits)
+	case FilterPolicyReject:
+		comment.State = CommentStateRejected
+	case FilterPolicyReview:
+		if comment.State == CommentStatePublished {
+			comment.State = CommentStatePending
+		}
+	}
+	uc.log.Infof("comment %d hit %d sensitive words, policy: %d", comment.Id, len(hits), policy)
+}
+
 func (uc *CommentUsecase) BuildCommentIndexCache(ctx context.Context, param CommentIndexCache) error {
 	return uc.repo.BuildCommentIndexCache(ctx, param)
 }
 
-// LikeComment 评论点赞
-func (uc *CommentUsecase) LikeComment(ctx context.Context, id uint64, like int, memberId uint64) error {
-	comment := &Comment{
-		Id: id,
-		Like: like,
-		MemberId: memberId,
-	}
-	return uc.repo.UpdateLikeNum(ctx, comment)
+// UpdateCommentScore 重新计算评论所属根评论的排序分数
+func (uc *CommentUsecase) UpdateCommentScore(ctx context.Context, id uint64) error {
+	return uc.repo.UpdateCommentScore(ctx, id)
+}
+
+// BuildCommentScoreCache 构建主题的热度与点赞排序缓存
+func (uc *CommentUsecase) BuildCommentScoreCache(ctx context.Context, objId uint64, objType int) error {
+	return uc.repo.BuildCommentScoreCache(ctx, objId, objType)
 }
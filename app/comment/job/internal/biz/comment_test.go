@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHotScore(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		higher, lower float64
+	}{
+		{"more likes", HotScore(10, 0, now), HotScore(1, 0, now)},
+		{"reply weighs more than like", HotScore(0, 1, now), HotScore(1, 0, now)},
+		{"newer", HotScore(1, 0, now.Add(time.Hour)), HotScore(1, 0, now)},
+		{"decay", HotScore(1, 0, now.Add(time.Duration(hotScoreDecay) * time.Second)), HotScore(9, 0, now)},
+	}
+	for _, tt := range tests {
+		if tt.higher <= tt.lower {
+			t.Errorf("%s: %v <= %v", tt.name, tt.higher, tt.lower)
+		}
+	}
+	if HotScore(0, 0, now) != HotScore(-3, 1, now) {
+		t.Errorf("weight below 1 should be treated as 1")
+	}
+}
+
+func TestTopScore(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		higher, lower float64
+	}{
+		{"more likes", TopScore(2, now), TopScore(1, now)},
+		{"likes before time", TopScore(2, now), TopScore(1, now.Add(24 * 365 * time.Hour))},
+		{"newer on tie", TopScore(1, now.Add(time.Second)), TopScore(1, now)},
+	}
+	for _, tt := range tests {
+		if tt.higher <= tt.lower {
+			t.Errorf("%s: %v <= %v", tt.name, tt.higher, tt.lower)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 死信状态
+const (
+	DeadLetterStatePending int8 = iota // 待处理
+	DeadLetterStateReplayed            // 已重新发送到原主题
+	DeadLetterStateDiscarded           // 已丢弃
+)
+
+// maxDeadLetterPageSize 每页最多返回的死信数
+const maxDeadLetterPageSize = 100
+
+// DeadLetter 重试后仍处理失败的消息
+type DeadLetter struct {
+	Id uint64
+	Topic string // 原消息的主题
+	Partition int32
+	Offset int64
+	Key string // 原消息的 key, 重新发送时保持分区顺序
+	Headers map[string]string
+	Value []byte
+	Reason string
+	Attempts int
+	State int8
+	FailedAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type DeadLetterRepo interface {
+	// SaveDeadLetter 保存死信, 同一条原消息只保存一次
+	SaveDeadLetter(ctx context.Context, deadLetter *DeadLetter) error
+	ListDeadLetter(ctx context.Context, topic string, state int8, page, size int) ([]*DeadLetter, error)
+	// ReplayDeadLetter 将待处理的死信重新发送到原主题, 返回发送的条数
+	ReplayDeadLetter(ctx context.Context, ids []uint64) (int, error)
+	// DiscardDeadLetter 丢弃待处理的死信, 返回丢弃的条数
+	DiscardDeadLetter(ctx context.Context, ids []uint64) (int, error)
+}
+
+type DeadLetterUsecase struct {
+	repo DeadLetterRepo
+	log *log.Helper
+}
+
+func NewDeadLetterUsecase(repo DeadLetterRepo, logger log.Logger) *DeadLetterUsecase {
+	return &DeadLetterUsecase{
+		repo: repo,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (uc *DeadLetterUsecase) SaveDeadLetter(ctx context.Context, deadLetter *DeadLetter) error {
+	return uc.repo.SaveDeadLetter(ctx, deadLetter)
+}
+
+// ListDeadLetter 按失败时间倒序查询死信, topic 为空时查询所有主题
+func (uc *DeadLetterUsecase) ListDeadLetter(ctx context.Context, topic string, state int8, page, size int) ([]*DeadLetter, error) {
+	if size <= 0 || size > maxDeadLetterPageSize {
+		size = maxDeadLetterPageSize
+	}
+	return uc.repo.ListDeadLetter(ctx, topic, state, page, size)
+}
+
+func (uc *DeadLetterUsecase) ReplayDeadLetter(ctx context.Context, ids []uint64) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := uc.repo.ReplayDeadLetter(ctx, ids)
+	uc.log.Infof("replayed %d of %d dead letters", count, len(ids))
+	return count, err
+}
+
+func (uc *DeadLetterUsecase) DiscardDeadLetter(ctx context.Context, ids []uint64) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := uc.repo.DiscardDeadLetter(ctx, ids)
+	uc.log.Infof("discarded %d of %d dead letters", count, len(ids))
+	return count, err
+}
@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 通知类型, 与评论服务保持一致
+const (
+	NotificationTypeReply int8 = iota + 1 // 评论被回复
+	NotificationTypeMention               // 在评论中被 @
+	NotificationTypeLike                  // 评论被点赞, 未读期间的点赞合并为一条
+)
+
+// Notification 发给成员的一条通知
+type Notification struct {
+	MemberId uint64
+	Type int8
+	CommentId uint64
+	ObjId uint64
+	ObjType int
+	ActorId uint64
+}
+
+type NotificationRepo interface {
+	// CreateNotification 新增一条未读通知
+	CreateNotification(ctx context.Context, n *Notification) error
+	// MergeNotification 合并到同一评论同类型的未读通知中, 没有未读通知时新增
+	MergeNotification(ctx context.Context, n *Notification) error
+}
+
+type NotificationUsecase struct {
+	repo NotificationRepo
+	commentRepo CommentRepo
+	log *log.Helper
+}
+
+func NewNotificationUsecase(repo NotificationRepo, commentRepo CommentRepo, logger log.Logger) *NotificationUsecase {
+	return &NotificationUsecase{
+		repo: repo,
+		commentRepo: commentRepo,
+		log: log.NewHelper(logger),
+	}
+}
+
+// NotifyComment 评论保存后通知被回复的作者与被 @ 的成员, 不通知评论作者自己, 每个成员只通知一次
+// 不可见的评论(如待审核)不发通知
+func (uc *NotificationUsecase) NotifyComment(ctx context.Context, subject *CommentSubject, comment *Comment) error {
+	if !IsCommentVisible(comment.State) {
+		return nil
+	}
+	notified := map[uint64]bool{comment.MemberId: true}
+	notify := func(memberId uint64, typ int8) error {
+		if memberId == 0 || notified[memberId] {
+			return nil
+		}
+		notified[memberId] = true
+		return uc.repo.CreateNotification(ctx, &Notification{
+			MemberId: memberId,
+			Type: typ,
+			CommentId: comment.Id,
+			ObjId: subject.ObjId,
+			ObjType: subject.ObjType,
+			ActorId: comment.MemberId,
+		})
+	}
+	if comment.Parent != 0 {
+		parentMemberId := comment.ParentMemberId
+		if parentMemberId == 0 {
+			parent, err := uc.commentRepo.GetComment(ctx, comment.Parent)
+			if err != nil {
+				return err
+			}
+			if parent != nil {
+				parentMemberId = parent.MemberId
+			}
+		}
+		if err := notify(parentMemberId, NotificationTypeReply); err != nil {
+			return err
+		}
+	}
+	for _, mention := range comment.Mentions {
+		if err := notify(mention.MemberId, NotificationTypeMention); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NotifyReaction 评论被点赞后通知作者, 作者未读期间的点赞合并为一条
+func (uc *NotificationUsecase) NotifyReaction(ctx context.Context, commentId uint64, memberId uint64, reaction string) error {
+	if reaction != ReactionLike {
+		return nil
+	}
+	comment, err := uc.commentRepo.GetComment(ctx, commentId)
+	if err != nil {
+		return err
+	}
+	if comment == nil || comment.MemberId == memberId {
+		return nil
+	}
+	return uc.repo.MergeNotification(ctx, &Notification{
+		MemberId: comment.MemberId,
+		Type: NotificationTypeLike,
+		CommentId: comment.Id,
+		ObjId: comment.ObjId,
+		ObjType: comment.ObjType,
+		ActorId: memberId,
+	})
+}
@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"base-service/app/comment/job/internal/conf"
+	"context"
+	"expvar"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// reconcileMetrics 计数校正的统计, 通过 /debug/vars 查看
+// subjects 校正的主题数, errors 校正失败的主题数, drift.<field> 不一致的计数, fixed 已修正的计数
+var reconcileMetrics = expvar.NewMap("comment_reconcile")
+
+// CounterDrift 存储的计数与重新计算的结果不一致
+type CounterDrift struct {
+	SubjectId uint64
+	CommentId uint64 // 为 0 表示主题的计数
+	Field string
+	Stored int
+	Actual int
+}
+
+type ReconcileRepo interface {
+	// GetSubjectId 查询主题id, 主题不存在时返回 0
+	GetSubjectId(ctx context.Context, objId uint64, objType int) (uint64, error)
+	// ListSubjectIds 按id顺序查询 afterId 之后的主题
+	ListSubjectIds(ctx context.Context, afterId uint64, limit int) ([]uint64, error)
+	// ReconcileSubject 按 comment_index 与 comment_reaction 重新计算主题及其评论的计数, dryRun 为 false 时修正不一致的计数
+	ReconcileSubject(ctx context.Context, subjectId uint64, dryRun bool) ([]*CounterDrift, error)
+	// TryLockReconcile 获取定时校正的租约, 其他实例持有时返回 false
+	TryLockReconcile(ctx context.Context, ttl time.Duration) (bool, error)
+}
+
+// ReconcileUsecase 计数只在原值上增减, 写入失败会永久偏差, 定时按明细重新计算并修正
+type ReconcileUsecase struct {
+	repo ReconcileRepo
+	interval time.Duration
+	batchSize int
+	dryRun bool
+	log *log.Helper
+}
+
+func NewReconcileUsecase(repo ReconcileRepo, c *conf.Data, logger log.Logger) (*ReconcileUsecase, func(), error) {
+	uc := &ReconcileUsecase{
+		repo: repo,
+		interval: c.GetReconcile().GetInterval().AsDuration(),
+		batchSize: int(c.GetReconcile().GetBatchSize()),
+		dryRun: c.GetReconcile().GetDryRun(),
+		log: log.NewHelper(logger),
+	}
+	if uc.interval == 0 {
+		uc.interval = time.Hour
+	}
+	if uc.batchSize <= 0 {
+		uc.batchSize = 100
+	}
+	if uc.interval < 0 {
+		return uc, func() {}, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		uc.run(ctx)
+	}()
+	return uc, func() {
+		cancel()
+		<-done
+	}, nil
+}
+
+// run 启动时校正一次, 之后按间隔定时校正
+func (uc *ReconcileUsecase) run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		uc.tryReconcileAll(ctx)
+		timer.Reset(uc.interval)
+	}
+}
+
+// tryReconcileAll 每个 job 实例都会定时触发, 租约为一个间隔且不主动释放, 每个间隔只由一个实例校正
+func (uc *ReconcileUsecase) tryReconcileAll(ctx context.Context) {
+	locked, err := uc.repo.TryLockReconcile(ctx, uc.interval)
+	if err != nil {
+		uc.log.Errorf("lock reconcile err: %v", err)
+		return
+	}
+	if !locked {
+		return
+	}
+	uc.ReconcileAll(ctx, uc.dryRun)
+}
+
+// ReconcileAll 分批校正所有主题, 单个主题失败时记录日志后继续
+func (uc *ReconcileUsecase) ReconcileAll(ctx context.Context, dryRun bool) {
+	var afterId uint64
+	subjects, drifts := 0, 0
+	for ctx.Err() == nil {
+		ids, err := uc.repo.ListSubjectIds(ctx, afterId, uc.batchSize)
+		if err != nil {
+			uc.log.Errorf("list subjects after %d err: %v", afterId, err)
+			return
+		}
+		for _, id := range ids {
+			result, err := uc.reconcile(ctx, id, dryRun)
+			if err != nil {
+				continue
+			}
+			subjects++
+			drifts += len(result)
+		}
+		if len(ids) < uc.batchSize {
+			break
+		}
+		afterId = ids[len(ids) - 1]
+	}
+	uc.log.Infof("reconciled %d subjects, %d counters drifted, dry run: %v", subjects, drifts, dryRun)
+}
+
+// ReconcileCounter 校正一个主题的计数
+func (uc *ReconcileUsecase) ReconcileCounter(ctx context.Context, objId uint64, objType int, dryRun bool) ([]*CounterDrift, error) {
+	id, err := uc.repo.GetSubjectId(ctx, objId, objType)
+	if err != nil {
+		return nil, err
+	}
+	if id == 0 {
+		return nil, errors.NotFound("SUBJECT_NOT_FOUND", "comment subject not found")
+	}
+	return uc.reconcile(ctx, id, dryRun)
+}
+
+func (uc *ReconcileUsecase) reconcile(ctx context.Context, subjectId uint64, dryRun bool) ([]*CounterDrift, error) {
+	drifts, err := uc.repo.ReconcileSubject(ctx, subjectId, dryRun)
+	if err != nil {
+		reconcileMetrics.Add("errors", 1)
+		uc.log.Errorf("reconcile subject %d err: %v", subjectId, err)
+		return nil, err
+	}
+	reconcileMetrics.Add("subjects", 1)
+	for _, drift := range drifts {
+		reconcileMetrics.Add("drift." + drift.Field, 1)
+		if !dryRun {
+			reconcileMetrics.Add("fixed", 1)
+		}
+		uc.log.Warnf("subject %d comment %d %s drifted, stored: %d, actual: %d, dry run: %v",
+			drift.SubjectId, drift.CommentId, drift.Field, drift.Stored, drift.Actual, dryRun)
+	}
+	return drifts, nil
+}
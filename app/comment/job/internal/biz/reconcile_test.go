@@ -0,0 +1,63 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"testing"
+	"time"
+)
+
+// fakeReconcileRepo 记录校正的主题
+type fakeReconcileRepo struct {
+	ReconcileRepo
+	locked bool
+	lockErr error
+	ttl time.Duration
+	subjects []uint64
+	reconciled []uint64
+}
+
+func (r *fakeReconcileRepo) TryLockReconcile(ctx context.Context, ttl time.Duration) (bool, error) {
+	r.ttl = ttl
+	return r.locked, r.lockErr
+}
+
+func (r *fakeReconcileRepo) ListSubjectIds(ctx context.Context, afterId uint64, limit int) ([]uint64, error) {
+	var ids []uint64
+	for _, id := range r.subjects {
+		if id > afterId && len(ids) < limit {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeReconcileRepo) ReconcileSubject(ctx context.Context, subjectId uint64, dryRun bool) ([]*CounterDrift, error) {
+	r.reconciled = append(r.reconciled, subjectId)
+	return nil, nil
+}
+
+func TestTryReconcileAll(t *testing.T) {
+	tests := []struct {
+		name string
+		locked bool
+		lockErr error
+		want int
+	}{
+		{"locked", true, nil, 3},
+		{"held by another job", false, nil, 0},
+		{"lock error", false, errors.New("redis down"), 0},
+	}
+	for _, tt := range tests {
+		repo := &fakeReconcileRepo{locked: tt.locked, lockErr: tt.lockErr, subjects: []uint64{1, 2, 3}}
+		uc := &ReconcileUsecase{repo: repo, interval: time.Hour, batchSize: 2, log: log.NewHelper(log.DefaultLogger)}
+		uc.tryReconcileAll(context.Background())
+		if len(repo.reconciled) != tt.want {
+			t.Errorf("%s: reconciled %v, want %d subjects", tt.name, repo.reconciled, tt.want)
+		}
+		if repo.ttl != time.Hour {
+			t.Errorf("%s: lock ttl %v, want the interval", tt.name, repo.ttl)
+		}
+	}
+}
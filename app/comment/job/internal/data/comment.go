@@ -1,14 +1,19 @@
 package data
 
 import (
+	jobV1 "base-service/api/comment/job/v1"
 	"base-service/app/comment/job/internal/biz"
 	"base-service/pkg/orm"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"math"
+	"strconv"
 	"time"
 )
 
@@ -37,6 +42,7 @@ type CommentIndex struct {
 	Like int `gorm:"default:0"`
 	Hate int `gorm:"default:0"`
 	State int8 `gorm:"default:0"`
+	PinnedAt *time.Time
 	Content CommentContent `gorm:"foreignKey:Id"`
 }
 
@@ -52,18 +58,34 @@ type CommentContent struct {
 	Device string
 	Message string
 	Meta string
+	EditedAt *time.Time
+	Mentions string `gorm:"type:text"` // 评论中 @ 的成员, json 数组
 }
 
-type CommentLike struct {
-	MemberId uint64 `gorm:"primaryKey;autoIncrement:false"`
+// CommentFilterHit 评论命中的敏感词, 供审核时查看
+type CommentFilterHit struct {
 	CommentId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Policy int8
+	Hits string `gorm:"type:text"` // 命中详情, json 数组
 	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt
 }
 
 // 表明定义
 
+// CommentIdempotency 成员的请求id对应的评论, 评论服务的去重窗口过期后重复的请求仍然只保存一条评论
+// 评论服务在去重窗口过期后按此识别重复的请求, 超过保留时长后清理
+type CommentIdempotency struct {
+	MemberId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	IdempotencyKey string `gorm:"primaryKey;size:64"`
+	CommentId uint64
+	Fingerprint string `gorm:"size:64"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
+func (CommentIdempotency) TableName() string {
+	return "comment_idempotency"
+}
+
 func (CommentSubject) TableName() string {
 	return "comment_subject"
 }
@@ -76,8 +98,8 @@ func (CommentContent) TableName() string {
 	return "comment_content"
 }
 
-func (CommentLike) TableName() string {
-	return "comment_like"
+func (CommentFilterHit) TableName() string {
+	return "comment_filter_hit"
 }
 
 // repo 定义 实现
@@ -107,7 +129,33 @@ func (c commentRepo) CreateSubject(ctx context.Context, subject *biz.CommentSubj
 	cs.Id = orm.NextId()
 	result := c.data.db.WithContext(ctx).Create(&cs)
 	subject.Id = cs.Id
-	return result.Error
+	if result.Error != nil {
+		return result.Error
+	}
+	c.invalidateSubjectMiss(ctx, subject.ObjId, subject.ObjType)
+	return nil
+}
+
+// 评论服务缓存的不存在的主题, 与主题的创建次数
+const subjectMissTTL = time.Minute
+
+func subjectMissKey(objId uint64, objType int) string {
+	return fmt.Sprintf("csm:%d:%d", objId, objType)
+}
+
+func subjectVersionKey(objId uint64, objType int) string {
+	return fmt.Sprintf("csv:%d:%d", objId, objType)
+}
+
+// invalidateSubjectMiss 主题创建提交后删除评论服务缓存的不存在的主题, 并使评论服务查询中的请求不再写入
+func (c commentRepo) invalidateSubjectMiss(ctx context.Context, objId uint64, objType int) {
+	pipe := c.data.redisDB.TxPipeline()
+	pipe.Incr(ctx, subjectVersionKey(objId, objType))
+	pipe.Expire(ctx, subjectVersionKey(objId, objType), subjectMissTTL)
+	pipe.Del(ctx, subjectMissKey(objId, objType))
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.log.Errorf("invalidate subject miss %d-%d err: %v", objId, objType, err)
+	}
 }
 
 
@@ -135,6 +183,7 @@ func (c commentRepo) queryOrCreateSubject(ctx context.Context, objId uint64, obj
 			if saveResult.RowsAffected == 1 {
 				sbj.Id = id
 				sbj.CreatedAt = t
+				c.invalidateSubjectMiss(ctx, objId, objType)
 				return &sbj, nil
 			} else {
 				// 不为1则已经有数据插入，再次查询并返回
@@ -150,19 +199,42 @@ func (c commentRepo) queryOrCreateSubject(ctx context.Context, objId uint64, obj
 }
 
 
+// BuildCommentIndexCache 从最新楼层开始构建到请求页为止的缓存, 保证缓存是连续的区间, 评论服务按游标读取时不会跳过评论
+// 置顶评论由评论服务单独查询, 不进入缓存
 func (c commentRepo) BuildCommentIndexCache(ctx context.Context, param biz.CommentIndexCache) error {
-	offset := getOffset(param.Page, param.Size)
-	var indexList []*CommentIndex
-	indexResult := c.data.db.WithContext(ctx).
-		Joins("Content").
-		Where("obj_id = ? AND obj_type = ? AND root = ?", param.ObjId, param.ObjType, 0).
+	query := func() *gorm.DB {
+		return c.data.db.WithContext(ctx).
+			Where("obj_id = ? AND obj_type = ? AND root = ?", param.ObjId, param.ObjType, 0).
+			Where("comment_index.state IN ?", biz.CommentVisibleStates).
+			Where("comment_index.pinned_at IS NULL")
+	}
+	// 查出请求页最后一条的楼层
+	pageQuery := query()
+	if param.Floor > 0 {
+		pageQuery = pageQuery.Where("floor < ?", param.Floor)
+	}
+	var floors []int
+	pageResult := pageQuery.Model(&CommentIndex{}).
 		Order("floor desc").
 		Limit(param.Size).
-		Offset(offset).
+		Pluck("floor", &floors)
+	if pageResult.Error != nil {
+		return pageResult.Error
+	}
+	var indexList []*CommentIndex
+	indexQuery := query().Joins("Content")
+	if len(floors) > 0 {
+		indexQuery = indexQuery.Where("floor >= ?", floors[len(floors) - 1])
+	}
+	indexResult := indexQuery.
+		Order("floor desc").
 		Find(&indexList)
 	if indexResult.Error != nil {
 		return indexResult.Error
 	}
+	if len(indexList) == 0 {
+		return nil
+	}
 	zList := make([]*redis.Z, len(indexList))
 	for i := range indexList {
 		zList[i] = &redis.Z{
@@ -176,6 +248,18 @@ func (c commentRepo) BuildCommentIndexCache(ctx context.Context, param biz.Comme
 
 
 func (c commentRepo) SaveComment(ctx context.Context, subject *biz.CommentSubject, comment *biz.Comment) error {
+	// 消息在位移提交前可能被重复投递, 已保存的评论直接跳过
+	if comment.Id != 0 {
+		var count int64
+		result := c.data.db.WithContext(ctx).Unscoped().Model(&CommentIndex{}).Where("id = ?", comment.Id).Count(&count)
+		if result.Error != nil {
+			return result.Error
+		}
+		if count > 0 {
+			c.log.Infof("comment %d already saved, skip", comment.Id)
+			return nil
+		}
+	}
 	// 查询subject
 	savedSbj, err := c.queryOrCreateSubject(ctx, subject.ObjId, subject.ObjType, subject.MemberId)
 	if err != nil {
@@ -190,21 +274,35 @@ func (c commentRepo) SaveComment(ctx context.Context, subject *biz.CommentSubjec
 		Message:     comment.Message,
 		Meta:        comment.Meta,
 	}
+	if len(comment.Mentions) > 0 {
+		mentions, err := json.Marshal(comment.Mentions)
+		if err != nil {
+			return err
+		}
+		content.Mentions = string(mentions)
+	}
 	content.Id = comment.Id
-	r := c.data.db.WithContext(ctx).
-		Create(&content)
-	if r.Error != nil {
-		return r.Error
+	// 不可见的评论(如待审核)不计入计数, 审核通过时由评论服务调整
+	delta := 0
+	if biz.IsCommentVisible(comment.State) {
+		delta = 1
 	}
-	// 事务更新subject和index表
+	// 事务插入内容并更新subject和index表
 	err = c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 更新subject
+		if err := claimIdempotencyKey(tx, comment); err != nil {
+			return err
+		}
+		// 插入内容与索引在同一事务中, 失败重试时不会留下孤立的内容
+		if err := tx.Create(&content).Error; err != nil {
+			return err
+		}
+		// 更新subject, 计数不变时也需要更新以锁定主题行, 保证楼层分配串行
 		updateFields := map[string]interface{} {
-			"count": gorm.Expr("count + ?", 1),
-			"all_count": gorm.Expr("all_count + ?", 1),
+			"count": gorm.Expr("count + ?", delta),
+			"all_count": gorm.Expr("all_count + ?", delta),
 		}
 		if comment.Root == 0 {
-			updateFields["root_count"] = gorm.Expr("root_count + ?", 1)
+			updateFields["root_count"] = gorm.Expr("root_count + ?", delta)
 		}
 		result := tx.Debug().Model(&savedSbj).
 			Updates(updateFields)
@@ -216,25 +314,23 @@ func (c commentRepo) SaveComment(ctx context.Context, subject *biz.CommentSubjec
 		if result.Error != nil {
 			return result.Error
 		}
-		// 非根评论，楼层为根评论的楼层计数, 同时需要更新该评论的根评论楼层数
-		floor := savedSbj.RootCount
+		// 非根评论需要同时更新该评论的根评论楼层数
 		if comment.Root != 0 {
 			rootIndex := CommentIndex{}
 			rootIndex.Id = comment.Root
 			result = tx.Model(&rootIndex).
 				Updates(map[string]interface{}{
-					"count": gorm.Expr("count + ?", 1),
-					"root_count": gorm.Expr("root_count + ?", 1),
+					"count": gorm.Expr("count + ?", delta),
+					"root_count": gorm.Expr("root_count + ?", delta),
 				})
 			if result.Error != nil {
 				return result.Error
 			}
-			// 查询最新楼层
-			result = tx.First(&rootIndex, comment.Root)
-			if result.Error != nil {
-				return result.Error
-			}
-			floor = rootIndex.RootCount
+		}
+		// 计数会因删除评论而减少, 楼层取已分配的最大楼层+1
+		floor, err := nextFloor(tx, savedSbj.Id, comment.Root)
+		if err != nil {
+			return err
 		}
 		// 插入index表
 		ci := CommentIndex{
@@ -245,72 +341,386 @@ func (c commentRepo) SaveComment(ctx context.Context, subject *biz.CommentSubjec
 			Root:      comment.Root,
 			Parent:    comment.Parent,
 			Floor:     floor,
+			State:     comment.State,
 		}
 		ci.Id = content.Id
 		comment.Id = content.Id
-		return tx.Create(&ci).Error
+		if err = tx.Create(&ci).Error; err != nil {
+			return err
+		}
+		if err = saveCommentFilterHit(tx, comment); err != nil {
+			return err
+		}
+		// 缓存、排序分数与通知由消费创建事件驱动, 保存成功后事件一定会被投递
+		return saveCommentEvent(tx, subject.ObjId, subject.ObjType, &jobV1.CommentEvent{
+			Event: &jobV1.CommentEvent_Created{Created: commentCreated(subject, comment)},
+		})
 	})
-	if err == nil {
-		go c.UpdateCommentIndexCache(*comment)
+	if err == errDuplicateComment {
+		c.log.Infof("comment %d duplicates request %s of member %d, skip", comment.Id, comment.IdempotencyKey, comment.MemberId)
+		return nil
 	}
 	return err
 }
 
-func (c commentRepo) UpdateCommentIndexCache(comment biz.Comment) {
-	// 查询刚保存的信息
-	var ci CommentIndex
-	result := c.data.db.First(&ci, comment.Id)
-	if result.Error != nil {
-		return
+// errDuplicateComment 成员相同请求id的评论已保存
+var errDuplicateComment = errors.New("duplicate comment")
+
+// claimIdempotencyKey 记录评论的请求id, 请求id已被其他评论使用时返回 errDuplicateComment
+func claimIdempotencyKey(tx *gorm.DB, comment *biz.Comment) error {
+	if comment.IdempotencyKey == "" {
+		return nil
 	}
-	c.data.redisDB.ZAdd(context.Background(), fmt.Sprintf("ci:%d:%d", ci.ObjId, ci.ObjType), &redis.Z{
-		Score: float64(ci.Floor),
-		Member: ci,
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&CommentIdempotency{
+		MemberId: comment.MemberId,
+		IdempotencyKey: comment.IdempotencyKey,
+		CommentId: comment.Id,
+		Fingerprint: comment.IdempotencyFingerprint,
 	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errDuplicateComment
+	}
+	return nil
+}
+
+func commentCreated(subject *biz.CommentSubject, comment *biz.Comment) *jobV1.CommentCreated {
+	mentions := make([]uint64, len(comment.Mentions))
+	for i, mention := range comment.Mentions {
+		mentions[i] = mention.MemberId
+	}
+	return &jobV1.CommentCreated{
+		CommentId: comment.Id,
+		ObjId: subject.ObjId,
+		ObjType: int32(subject.ObjType),
+		MemberId: comment.MemberId,
+		Root: comment.Root,
+		Parent: comment.Parent,
+		ParentMemberId: comment.ParentMemberId,
+		State: int32(comment.State),
+		MentionMemberIds: mentions,
+	}
 }
 
-func (c commentRepo) UpdateLikeNum(ctx context.Context, comment *biz.Comment) error {
-	if comment.Like == 0 {
+// saveCommentFilterHit 记录评论命中的敏感词
+func saveCommentFilterHit(tx *gorm.DB, comment *biz.Comment) error {
+	if len(comment.FilterHits) == 0 {
 		return nil
-	} else if comment.Like > 1 {
-		comment.Like = 1
-	} else if comment.Like < -1 {
-		comment.Like = -1
 	}
-	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 查询是否有记录
-		var likedRecord CommentLike
-		result := tx.
-			Where("member_id = ? AND comment_id = ?", comment.MemberId, comment.Id).
-			First(&likedRecord)
-		if result.Error != nil && result.Error != gorm.ErrRecordNotFound {
+	hits, err := json.Marshal(comment.FilterHits)
+	if err != nil {
+		return err
+	}
+	// 评论修改后重新过滤时覆盖之前的记录
+	return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&CommentFilterHit{
+		CommentId: comment.Id,
+		Policy: comment.FilterPolicy,
+		Hits: string(hits),
+	}).Error
+}
+
+// nextFloor 查询根评论或回复的下一个楼层, 包含已删除的评论以保证楼层不重复
+// 在主题锁内执行, 由评论服务创建的 idx_comment_index_subject (subject_id, root, floor) 索引支持
+func nextFloor(tx *gorm.DB, subjectId uint64, root uint64) (int, error) {
+	var floor int
+	result := tx.Unscoped().Model(&CommentIndex{}).
+		Select("COALESCE(MAX(floor), 0)").
+		Where("subject_id = ? AND root = ?", subjectId, root).
+		Scan(&floor)
+	return floor + 1, result.Error
+}
+
+// RefreshCommentIndexCache 刷新 ci 缓存中评论所属的根评论, 缓存未构建时跳过, 由下次查询回源构建
+// 已删除、不可见或置顶的根评论移出缓存
+func (c commentRepo) RefreshCommentIndexCache(ctx context.Context, id uint64) error {
+	var ci CommentIndex
+	result := c.data.db.WithContext(ctx).Unscoped().First(&ci, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return result.Error
+	}
+	key := fmt.Sprintf("ci:%d:%d", ci.ObjId, ci.ObjType)
+	exists, err := c.data.redisDB.Exists(ctx, key).Result()
+	if err != nil || exists == 0 {
+		return err
+	}
+	// 回复变更只影响根评论的计数
+	if ci.Root != 0 {
+		rootId := ci.Root
+		ci = CommentIndex{}
+		result = c.data.db.WithContext(ctx).Unscoped().First(&ci, rootId)
+		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return nil
+			}
 			return result.Error
-		} else if result.Error == gorm.ErrRecordNotFound && comment.Like == -1 {
-			return nil // 不存在记录，则不能减一
-		} else if result.Error == nil && comment.Like == 1 {
-			return nil // 存在记录时，不能加1
 		}
-		// 更新like数量
+	}
+	score := strconv.Itoa(ci.Floor)
+	removed, err := c.data.redisDB.ZRemRangeByScore(ctx, key, score, score).Result()
+	if err != nil {
+		return err
+	}
+	lowest, err := c.data.redisDB.ZRangeWithScores(ctx, key, 0, 0).Result()
+	if err != nil {
+		return err
+	}
+	if !inCommentIndexWindow(ci.Floor, removed, lowest) {
+		return nil
+	}
+	var index CommentIndex
+	result = c.data.db.WithContext(ctx).
+		Joins("Content").
+		Where("comment_index.state IN ?", biz.CommentVisibleStates).
+		Where("comment_index.pinned_at IS NULL").
+		First(&index, ci.Id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return result.Error
+	}
+	return c.data.redisDB.ZAdd(ctx, key, &redis.Z{
+		Score: float64(index.Floor),
+		Member: index,
+	}).Err()
+}
+
+// inCommentIndexWindow 判断根评论能否写回 ci 缓存
+// 缓存是从最新楼层开始的连续区间, 低于缓存最小楼层的评论写回后游标翻页会跳过中间未缓存的楼层
+// removed 为本次从缓存移出的条数, lowest 为移出后缓存中楼层最小的一条
+func inCommentIndexWindow(floor int, removed int64, lowest []redis.Z) bool {
+	if removed > 0 {
+		return true
+	}
+	return len(lowest) > 0 && float64(floor) >= lowest[0].Score
+}
+
+func (c commentRepo) GetComment(ctx context.Context, id uint64) (*biz.Comment, error) {
+	var ci CommentIndex
+	result := c.data.db.WithContext(ctx).First(&ci, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &biz.Comment{
+		Id:        ci.Id,
+		ObjId:     ci.ObjId,
+		ObjType:   ci.ObjType,
+		MemberId:  ci.MemberId,
+		Root:      ci.Root,
+		Parent:    ci.Parent,
+		Floor:     ci.Floor,
+		State:     ci.State,
+		CreatedAt: ci.CreatedAt,
+		UpdatedAt: ci.UpdatedAt,
+	}, nil
+}
+
+func (c commentRepo) GetCommentContent(ctx context.Context, id uint64) (*biz.Comment, error) {
+	comment, err := c.GetComment(ctx, id)
+	if err != nil || comment == nil {
+		return comment, err
+	}
+	var content CommentContent
+	result := c.data.db.WithContext(ctx).First(&content, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	comment.Message = content.Message
+	return comment, nil
+}
+
+// UpdateFilteredComment 保存修改后的评论重新过滤的结果, 可见性变化时调整计数
+// 以过滤时的状态与内容为条件更新, 事件重复投递、状态已被审核修改或内容已再次修改时跳过
+func (c commentRepo) UpdateFilteredComment(ctx context.Context, comment *biz.Comment, state int8, message string) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var ci CommentIndex
-		ci.Id = comment.Id
-		result = tx.Model(&ci).Updates(orm.UpdateFields{
-			"like": gorm.Expr("`like` + ?", comment.Like),
-		})
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&ci, comment.Id)
 		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return nil
+			}
 			return result.Error
 		}
-		// 插入或删除记录
-		if comment.Like == 1 {
-			like := CommentLike{
-				CommentId: comment.Id,
-				MemberId: comment.MemberId,
+		if ci.State != state {
+			c.log.Infof("comment %d state changed to %d, skip filter result", comment.Id, ci.State)
+			return nil
+		}
+		var content CommentContent
+		result = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&content, comment.Id)
+		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return result.Error
+		}
+		// 内容已被再次修改, 由新的编辑事件处理
+		if content.Message != message {
+			return nil
+		}
+		if comment.Message != message {
+			result = tx.Model(&content).Update("message", comment.Message)
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+		if comment.State != state {
+			result = tx.Model(&ci).Update("state", comment.State)
+			if result.Error != nil {
+				return result.Error
+			}
+			delta := 0
+			if biz.IsCommentVisible(comment.State) {
+				delta++
+			}
+			if biz.IsCommentVisible(state) {
+				delta--
 			}
-			result = tx.Create(&like)
+			if delta != 0 {
+				if err := updateCommentCount(tx, &ci, delta); err != nil {
+					return err
+				}
+			}
+		}
+		return saveCommentFilterHit(tx, comment)
+	})
+}
+
+// updateCommentCount 调整评论所在主题的计数, 回复还需调整根评论的回复数
+func updateCommentCount(tx *gorm.DB, ci *CommentIndex, delta int) error {
+	updateFields := orm.UpdateFields{
+		"count": gorm.Expr("count + ?", delta),
+		"all_count": gorm.Expr("all_count + ?", delta),
+	}
+	if ci.Root == 0 {
+		updateFields["root_count"] = gorm.Expr("root_count + ?", delta)
+	}
+	sbj := CommentSubject{}
+	sbj.Id = ci.SubjectId
+	result := tx.Model(&sbj).Updates(updateFields)
+	if result.Error != nil {
+		return result.Error
+	}
+	if ci.Root != 0 {
+		rootIndex := CommentIndex{}
+		rootIndex.Id = ci.Root
+		result = tx.Model(&rootIndex).Updates(orm.UpdateFields{
+			"count": gorm.Expr("count + ?", delta),
+			"root_count": gorm.Expr("root_count + ?", delta),
+		})
+	}
+	return result.Error
+}
+
+// UpdateCommentScore 重新计算评论所属根评论的排序分数
+// 主题的排序缓存未构建时跳过, 构建时会统一计算; 已删除、不可见或置顶的根评论移出缓存
+func (c commentRepo) UpdateCommentScore(ctx context.Context, id uint64) error {
+	var ci CommentIndex
+	result := c.data.db.WithContext(ctx).Unscoped().First(&ci, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return result.Error
+	}
+	if ci.Root != 0 {
+		rootId := ci.Root
+		ci = CommentIndex{}
+		result = c.data.db.WithContext(ctx).Unscoped().First(&ci, rootId)
+		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return result.Error
+		}
+	}
+	member := strconv.FormatUint(ci.Id, 10)
+	for _, sort := range []int{biz.CommentSortHot, biz.CommentSortTop} {
+		key := commentScoreKey(sort, ci.ObjId, ci.ObjType)
+		exists, err := c.data.redisDB.Exists(ctx, key).Result()
+		if err != nil {
+			return err
+		}
+		if exists == 0 {
+			continue
+		}
+		if ci.DeletedAt.Valid || !biz.IsCommentVisible(ci.State) || ci.PinnedAt != nil {
+			err = c.data.redisDB.ZRem(ctx, key, member).Err()
 		} else {
-			result = tx.Where("member_id = ? AND comment_id = ?", comment.MemberId, comment.Id).
-				Unscoped().Delete(CommentLike{})
+			err = c.data.redisDB.ZAdd(ctx, key, &redis.Z{
+				Score: commentScore(sort, &ci),
+				Member: member,
+			}).Err()
 		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildCommentScoreCache 按主题下所有可见且未置顶的根评论构建热度与点赞排序缓存
+func (c commentRepo) BuildCommentScoreCache(ctx context.Context, objId uint64, objType int) error {
+	var indexList []*CommentIndex
+	result := c.data.db.WithContext(ctx).
+		Where("obj_id = ? AND obj_type = ? AND root = ?", objId, objType, 0).
+		Where("state IN ? AND pinned_at IS NULL", biz.CommentVisibleStates).
+		Find(&indexList)
+	if result.Error != nil {
 		return result.Error
-	})
+	}
+	pipe := c.data.redisDB.TxPipeline()
+	// 没有评论的主题写入只包含占位成员的缓存, 避免每次查询都通知构建; 过期后由下次查询重建
+	if len(indexList) == 0 {
+		for _, sort := range []int{biz.CommentSortHot, biz.CommentSortTop} {
+			key := commentScoreKey(sort, objId, objType)
+			pipe.ZAdd(ctx, key, &redis.Z{Score: math.Inf(-1), Member: emptyScoreCacheMember})
+			pipe.Expire(ctx, key, emptyScoreCacheTTL)
+		}
+		_, err := pipe.Exec(ctx)
+		return err
+	}
+	for _, sort := range []int{biz.CommentSortHot, biz.CommentSortTop} {
+		zList := make([]*redis.Z, len(indexList))
+		for i := range indexList {
+			zList[i] = &redis.Z{
+				Score: commentScore(sort, indexList[i]),
+				Member: strconv.FormatUint(indexList[i].Id, 10),
+			}
+		}
+		pipe.ZAdd(ctx, commentScoreKey(sort, objId, objType), zList...)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
+// 空排序缓存的占位成员及其过期时间, 评论服务查询时跳过占位成员
+const (
+	emptyScoreCacheMember = "0"
+	emptyScoreCacheTTL = 10 * time.Minute
+)
+
+// commentScoreKey 主题的排序缓存, ch 按热度, ct 按点赞数, 成员为根评论id
+func commentScoreKey(sort int, objId uint64, objType int) string {
+	if sort == biz.CommentSortTop {
+		return fmt.Sprintf("ct:%d:%d", objId, objType)
+	}
+	return fmt.Sprintf("ch:%d:%d", objId, objType)
+}
+
+func commentScore(sort int, ci *CommentIndex) float64 {
+	if sort == biz.CommentSortTop {
+		return biz.TopScore(ci.Like, ci.CreatedAt)
+	}
+	return biz.HotScore(ci.Like, ci.RootCount, ci.CreatedAt)
+}
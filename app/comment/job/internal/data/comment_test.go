@@ -0,0 +1,111 @@
+package data
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// floorCache 模拟 ci 缓存的有序集合, 只保存楼层
+type floorCache []int
+
+func (c *floorCache) remove(floor int) int64 {
+	for i, f := range *c {
+		if f == floor {
+			*c = append((*c)[:i], (*c)[i+1:]...)
+			return 1
+		}
+	}
+	return 0
+}
+
+// lowest 对应 ZRANGE key 0 0 WITHSCORES
+func (c floorCache) lowest() []redis.Z {
+	if len(c) == 0 {
+		return nil
+	}
+	sorted := append([]int(nil), c...)
+	sort.Ints(sorted)
+	return []redis.Z{{Score: float64(sorted[0])}}
+}
+
+// refresh 与 RefreshCommentIndexCache 一致: 先移出, 仍可见且在缓存区间内才写回
+func (c *floorCache) refresh(floor int, visible bool) {
+	removed := c.remove(floor)
+	if visible && inCommentIndexWindow(floor, removed, c.lowest()) {
+		*c = append(*c, floor)
+	}
+}
+
+// page 与评论服务 listCommentIndexByFloor 一致: 缓存取到 size+1 条才算命中, 否则回源 db
+func (c floorCache) page(db []int, cursor int, size int) ([]int, bool) {
+	pick := func(floors []int) []int {
+		sorted := append([]int(nil), floors...)
+		sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+		var result []int
+		for _, f := range sorted {
+			if (cursor == 0 || f < cursor) && len(result) < size + 1 {
+				result = append(result, f)
+			}
+		}
+		return result
+	}
+	floors := pick(c)
+	if len(floors) <= size {
+		floors = pick(db)
+	}
+	if len(floors) > size {
+		return floors[:size], true
+	}
+	return floors, false
+}
+
+func TestInCommentIndexWindow(t *testing.T) {
+	lowest := []redis.Z{{Score: 6}}
+	tests := []struct {
+		name string
+		floor int
+		removed int64
+		lowest []redis.Z
+		want bool
+	}{
+		{"cached", 8, 1, lowest, true},
+		{"inside window", 7, 0, lowest, true},
+		{"newer than cache", 11, 0, lowest, true},
+		{"below window", 2, 0, lowest, false},
+		{"empty cache", 2, 0, nil, false},
+		{"last cached", 6, 1, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inCommentIndexWindow(tt.floor, tt.removed, tt.lowest); got != tt.want {
+				t.Errorf("inCommentIndexWindow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefreshBelowCommentIndexWindow(t *testing.T) {
+	db := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	// 第一页 size 为 4 时缓存 6-10 楼
+	cache := floorCache{10, 9, 8, 7, 6}
+	// 缓存区间以下的旧评论被点赞或编辑
+	cache.refresh(2, true)
+	cache.refresh(1, true)
+	var got []int
+	cursor := 0
+	for {
+		floors, hasMore := cache.page(db, cursor, 2)
+		got = append(got, floors...)
+		if !hasMore {
+			break
+		}
+		cursor = floors[len(floors) - 1]
+	}
+	want := []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pages = %v, want %v", got, want)
+	}
+}
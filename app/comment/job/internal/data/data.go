@@ -11,13 +11,13 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewCommentRepo)
+var ProviderSet = wire.NewSet(NewData, NewCommentRepo, NewNotificationRepo, NewDeadLetterRepo, NewReconcileRepo, NewWordFilter)
 
 // Data .
 type Data struct {
 	db *gorm.DB
 	redisDB *redis.Client
-	Kafka *Kafka
+	Queue Queue
 }
 
 // NewData .
@@ -28,6 +28,10 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		logg.Errorf("failed opening connection to mysql: %v", err)
 		return nil, nil, err
 	}
+	if err = migrate(db); err != nil {
+		logg.Errorf("failed migrating comment job tables: %v", err)
+		return nil, nil, err
+	}
 	// redis
 	r := redis.NewClient(&redis.Options{
 		Addr: c.Redis.Addr,
@@ -39,19 +43,62 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	// kafka
-	kafka, err := NewKafka(c.Kafka.Addr, logger)
+	// 消息队列
+	queue, err := NewQueue(c.Kafka, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	d := &Data{db: db,redisDB: r, Kafka: kafka}
+	d := &Data{db: db,redisDB: r, Queue: queue}
+	// 投递评论服务与 job 写入 outbox 的事件
+	relay := newOutboxRelay(db, r, queue, c.Outbox, logger)
+	go relay.run()
+	pruner := newIdempotencyPruner(db, r, c.Idempotency, logger)
+	go pruner.run()
 	cleanup := func() {
-		kafka.close()
+		pruner.close()
+		relay.close()
+		queue.close()
 		logg.Infof("comment service data clean up")
 	}
 	return d, cleanup, nil
 }
 
+// migrate 创建 job 自己使用的表, 包括只由 job 写入的敏感词命中记录
+// 评论相关的表由评论服务维护, outbox 由两者共同写入, 两边都会创建
+func migrate(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&CommentDeadLetter{}) {
+		if err := m.CreateTable(&CommentDeadLetter{}); err != nil {
+			return err
+		}
+	}
+	for _, table := range []interface{}{&CommentFilterHit{}, &CommentOutbox{}, &CommentIdempotency{}} {
+		if !m.HasTable(table) {
+			if err := m.CreateTable(table); err != nil {
+				return err
+			}
+		}
+	}
+	for _, column := range []string{"Key", "Headers"} {
+		if !m.HasColumn(&CommentDeadLetter{}, column) {
+			if err := m.AddColumn(&CommentDeadLetter{}, column); err != nil {
+				return err
+			}
+		}
+	}
+	if !m.HasColumn(&CommentIdempotency{}, "Fingerprint") {
+		if err := m.AddColumn(&CommentIdempotency{}, "Fingerprint"); err != nil {
+			return err
+		}
+	}
+	if !m.HasIndex(&CommentIdempotency{}, "CreatedAt") {
+		if err := m.CreateIndex(&CommentIdempotency{}, "CreatedAt"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getOffset(page, size int) int {
 	offset := (page - 1) * size
 	if offset < 0 {
@@ -0,0 +1,160 @@
+package data
+
+import (
+	"base-service/app/comment/job/internal/biz"
+	"base-service/pkg/orm"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// CommentDeadLetter 从死信主题保存的消息, 供管理员查看、重新发送或丢弃
+type CommentDeadLetter struct {
+	Id uint64 `gorm:"primarykey;autoIncrement:false"`
+	Topic string `gorm:"size:128;uniqueIndex:idx_dead_letter_source,priority:1"`
+	Partition int32 `gorm:"uniqueIndex:idx_dead_letter_source,priority:2"`
+	Offset int64 `gorm:"uniqueIndex:idx_dead_letter_source,priority:3"`
+	Key string `gorm:"size:255"`
+	Headers string `gorm:"type:text"` // 原消息的 header, json 对象
+	Value []byte `gorm:"type:mediumblob"` // 原消息内容, protobuf 编码
+	Reason string `gorm:"type:text"`
+	Attempts int
+	State int8 `gorm:"default:0;index"`
+	FailedAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (CommentDeadLetter) TableName() string {
+	return "comment_dead_letter"
+}
+
+type deadLetterRepo struct {
+	data *Data
+	log *log.Helper
+}
+
+func NewDeadLetterRepo(data *Data, logger log.Logger) biz.DeadLetterRepo {
+	return &deadLetterRepo{
+		data: data,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (d deadLetterRepo) SaveDeadLetter(ctx context.Context, deadLetter *biz.DeadLetter) error {
+	var headers string
+	if len(deadLetter.Headers) > 0 {
+		b, err := json.Marshal(deadLetter.Headers)
+		if err != nil {
+			return err
+		}
+		headers = string(b)
+	}
+	return d.data.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&CommentDeadLetter{
+			Id: orm.NextId(),
+			Topic: deadLetter.Topic,
+			Partition: deadLetter.Partition,
+			Offset: deadLetter.Offset,
+			Key: deadLetter.Key,
+			Headers: headers,
+			Value: deadLetter.Value,
+			Reason: deadLetter.Reason,
+			Attempts: deadLetter.Attempts,
+			State: biz.DeadLetterStatePending,
+			FailedAt: deadLetter.FailedAt,
+		}).Error
+}
+
+func (d deadLetterRepo) ListDeadLetter(ctx context.Context, topic string, state int8, page, size int) ([]*biz.DeadLetter, error) {
+	var list []*CommentDeadLetter
+	query := d.data.db.WithContext(ctx).Where("state = ?", state)
+	if topic != "" {
+		query = query.Where("topic = ?", topic)
+	}
+	result := query.Order("failed_at desc, id desc").
+		Limit(size).
+		Offset(getOffset(page, size)).
+		Find(&list)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	deadLetters := make([]*biz.DeadLetter, len(list))
+	for i, item := range list {
+		deadLetters[i] = &biz.DeadLetter{
+			Key:       item.Key,
+			Headers:   decodeHeaders(item.Headers),
+			Id:        item.Id,
+			Topic:     item.Topic,
+			Partition: item.Partition,
+			Offset:    item.Offset,
+			Value:     item.Value,
+			Reason:    item.Reason,
+			Attempts:  item.Attempts,
+			State:     item.State,
+			FailedAt:  item.FailedAt,
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+		}
+	}
+	return deadLetters, nil
+}
+
+// ReplayDeadLetter 逐条先将状态改为已重新发送再发送, 并发操作同一条死信时只有一方会发送
+// 发送失败时恢复为待处理并返回错误, 之前已发送的条数仍然有效
+func (d deadLetterRepo) ReplayDeadLetter(ctx context.Context, ids []uint64) (int, error) {
+	var list []*CommentDeadLetter
+	result := d.data.db.WithContext(ctx).
+		Where("id IN ? AND state = ?", ids, biz.DeadLetterStatePending).
+		Find(&list)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	count := 0
+	for _, item := range list {
+		claimed, err := d.updateState(ctx, item.Id, biz.DeadLetterStatePending, biz.DeadLetterStateReplayed)
+		if err != nil {
+			return count, err
+		}
+		if !claimed {
+			continue
+		}
+		if err = d.data.Queue.Send(item.Topic, item.Key, string(item.Value), decodeHeaders(item.Headers)); err != nil {
+			if _, e := d.updateState(ctx, item.Id, biz.DeadLetterStateReplayed, biz.DeadLetterStatePending); e != nil {
+				d.log.Errorf("restore dead letter %d err: %v", item.Id, e)
+			}
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (d deadLetterRepo) DiscardDeadLetter(ctx context.Context, ids []uint64) (int, error) {
+	result := d.data.db.WithContext(ctx).
+		Model(&CommentDeadLetter{}).
+		Where("id IN ? AND state = ?", ids, biz.DeadLetterStatePending).
+		Update("state", biz.DeadLetterStateDiscarded)
+	return int(result.RowsAffected), result.Error
+}
+
+func decodeHeaders(s string) map[string]string {
+	if s == "" {
+		return nil
+	}
+	var headers map[string]string
+	_ = json.Unmarshal([]byte(s), &headers)
+	return headers
+}
+
+// updateState 状态为 from 时改为 to, 返回是否修改成功
+func (d deadLetterRepo) updateState(ctx context.Context, id uint64, from, to int8) (bool, error) {
+	result := d.data.db.WithContext(ctx).
+		Model(&CommentDeadLetter{}).
+		Where("id = ? AND state = ?", id, from).
+		Update("state", to)
+	return result.RowsAffected == 1, result.Error
+}
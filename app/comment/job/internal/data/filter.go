@@ -0,0 +1,17 @@
+package data
+
+import (
+	"base-service/app/comment/job/internal/conf"
+	"base-service/app/comment/job/internal/pkg/wordfilter"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// NewWordFilter 加载敏感词词库, 词库文件修改后自动重新加载
+func NewWordFilter(c *conf.Data, logger log.Logger) (*wordfilter.Filter, func(), error) {
+	interval := c.GetFilter().GetReloadInterval().AsDuration()
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return wordfilter.NewFilter(c.GetFilter().GetWordFile(), interval, logger)
+}
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"base-service/app/comment/job/internal/conf"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	// defaultIdempotencyRetention comment_idempotency 中请求id的默认保留时长
+	defaultIdempotencyRetention = 30 * 24 * time.Hour
+	// idempotencyPruneInterval 清理过期请求id的间隔
+	idempotencyPruneInterval = time.Hour
+	// idempotencyPruneBatchSize 每次删除的请求id数, 分批删除避免长时间锁表
+	idempotencyPruneBatchSize = 1000
+)
+
+// idempotencyPruner 定期删除超过保留时长的请求id
+// 多个 job 实例通过 redis 租约每个间隔只由一个实例清理, 租约不主动释放
+type idempotencyPruner struct {
+	db *gorm.DB
+	lock *redisLock
+	retention time.Duration
+	stop chan struct{}
+	done chan struct{}
+	log *log.Helper
+}
+
+func newIdempotencyPruner(db *gorm.DB, rdb *redis.Client, c *conf.Data_Idempotency, logger log.Logger) *idempotencyPruner {
+	p := &idempotencyPruner{
+		db: db,
+		lock: newRedisLock(rdb, "comment:idempotency:prune", idempotencyPruneInterval),
+		retention: c.GetRetention().AsDuration(),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+		log: log.NewHelper(logger),
+	}
+	if p.retention <= 0 {
+		p.retention = defaultIdempotencyRetention
+	}
+	return p
+}
+
+func (p *idempotencyPruner) run() {
+	defer close(p.done)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-timer.C:
+		}
+		n, err := p.prune()
+		if err != nil {
+			p.log.Errorf("prune comment idempotency err: %v", err)
+		} else if n > 0 {
+			p.log.Infof("pruned %d comment idempotency keys", n)
+		}
+		timer.Reset(idempotencyPruneInterval)
+	}
+}
+
+// close 等待正在进行的清理完成
+func (p *idempotencyPruner) close() {
+	close(p.stop)
+	<-p.done
+}
+
+// prune 分批删除过期的请求id, 返回删除的数量, 其他实例已在本间隔内清理时跳过
+func (p *idempotencyPruner) prune() (int64, error) {
+	ctx := context.Background()
+	locked, err := p.lock.TryLock(ctx)
+	if err != nil || !locked {
+		return 0, err
+	}
+	before := time.Now().Add(-p.retention)
+	var total int64
+	for {
+		select {
+		case <-p.stop:
+			return total, nil
+		default:
+		}
+		result := p.db.WithContext(ctx).
+			Exec("DELETE FROM comment_idempotency WHERE created_at < ? LIMIT ?", before, idempotencyPruneBatchSize)
+		if result.Error != nil {
+			return total, result.Error
+		}
+		total += result.RowsAffected
+		if result.RowsAffected < idempotencyPruneBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package data
+
+import (
+	"base-service/pkg/orm"
+	"context"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+// unlockScript 只释放自己持有的锁
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// redisLock 多个 job 实例之间互斥的租约锁, 持有者退出后租约到期自动释放
+type redisLock struct {
+	rdb *redis.Client
+	key string
+	ttl time.Duration
+	token string
+}
+
+func newRedisLock(rdb *redis.Client, key string, ttl time.Duration) *redisLock {
+	return &redisLock{
+		rdb: rdb,
+		key: key,
+		ttl: ttl,
+		token: strconv.FormatUint(orm.NextId(), 10),
+	}
+}
+
+// TryLock 获取锁, 已被其他实例持有时返回 false
+func (l *redisLock) TryLock(ctx context.Context) (bool, error) {
+	return l.rdb.SetNX(ctx, l.key, l.token, l.ttl).Result()
+}
+
+func (l *redisLock) Unlock(ctx context.Context) error {
+	return unlockScript.Run(ctx, l.rdb, []string{l.key}, l.token).Err()
+}
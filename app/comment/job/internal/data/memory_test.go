@@ -0,0 +1,114 @@
+package data
+
+import (
+	"base-service/app/comment/job/internal/conf"
+	"base-service/pkg/memqueue"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestMemory() *Memory {
+	m := NewMemory(&conf.Data_Kafka{MaxRetries: 2, RetryBackoff: durationpb.New(time.Millisecond)}, log.DefaultLogger)
+	m.broker = memqueue.NewBroker()
+	return m
+}
+
+// deadLetterRecorder 记录死信主题收到的消息
+type deadLetterRecorder struct {
+	mu sync.Mutex
+	letters []DeadLetterMessage
+}
+
+func (r *deadLetterRecorder) handle(_ context.Context, msg *Message) error {
+	var letter DeadLetterMessage
+	if err := json.Unmarshal(msg.Value, &letter); err != nil {
+		return Permanent(err)
+	}
+	r.mu.Lock()
+	r.letters = append(r.letters, letter)
+	r.mu.Unlock()
+	return nil
+}
+
+func TestMemoryRetryAndDeadLetter(t *testing.T) {
+	tests := []struct {
+		name string
+		failures int
+		permanent bool
+		wantAttempts int
+		wantDeadLetter bool
+	}{
+		{"success", 0, false, 1, false},
+		{"retry then success", 2, false, 3, false},
+		{"retries exhausted", 100, false, 3, true},
+		{"permanent", 100, true, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestMemory()
+			recorder := &deadLetterRecorder{}
+			var mu sync.Mutex
+			attempts := 0
+			handler := func(_ context.Context, msg *Message) error {
+				mu.Lock()
+				defer mu.Unlock()
+				attempts++
+				if attempts <= tt.failures {
+					err := errors.New("failed")
+					if tt.permanent {
+						return Permanent(err)
+					}
+					return err
+				}
+				return nil
+			}
+			if err := m.Subscribe("topic", handler); err != nil {
+				t.Fatal(err)
+			}
+			if err := m.Subscribe("topic" + DeadLetterSuffix, recorder.handle); err != nil {
+				t.Fatal(err)
+			}
+			if err := m.Send("topic", "key", "value", nil); err != nil {
+				t.Fatal(err)
+			}
+			// close 处理完已收到的消息, 源主题产生的死信在死信主题关闭前保存
+			m.close()
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if got := len(recorder.letters) == 1; got != tt.wantDeadLetter {
+				t.Fatalf("dead letters = %v, want %v", recorder.letters, tt.wantDeadLetter)
+			}
+			if tt.wantDeadLetter {
+				letter := recorder.letters[0]
+				if letter.Topic != "topic" || letter.Key != "key" || string(letter.Value) != "value" || letter.Attempts != tt.wantAttempts {
+					t.Errorf("dead letter = %+v", letter)
+				}
+			}
+		})
+	}
+}
+
+func TestMemorySend(t *testing.T) {
+	m := newTestMemory()
+	if err := m.Send("topic", "", "value", nil); !errors.Is(err, memqueue.ErrNoSubscriber) {
+		t.Errorf("send without subscriber err = %v", err)
+	}
+	_ = m.Subscribe("topic", func(context.Context, *Message) error { return nil })
+	if err := m.Send("topic", "", "value", nil); err != nil {
+		t.Errorf("send err = %v", err)
+	}
+	m.close()
+	if err := m.Send("topic", "", "value", nil); err != errQueueClosed {
+		t.Errorf("send after close err = %v", err)
+	}
+	if err := m.Subscribe("topic", nil); err != errQueueClosed {
+		t.Errorf("subscribe after close err = %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"base-service/app/comment/job/internal/biz"
+	"base-service/pkg/orm"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// Notification 成员收到的通知, 表由评论服务维护, (member_id, type, comment_id, actor_id) 唯一
+// actor_id 是首个触发人, 合并时不修改, 最近的触发人写入 latest_actor_id
+type Notification struct {
+	Id uint64 `gorm:"primarykey;autoIncrement:false"`
+	MemberId uint64
+	Type int8
+	CommentId uint64
+	ObjId uint64
+	ObjType int
+	ActorId uint64
+	LatestActorId uint64
+	ActorCount int `gorm:"default:1"`
+	ReadAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotificationActor 合并通知的触发人
+type NotificationActor struct {
+	NotificationId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ActorId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time
+}
+
+// NotificationUnread 成员未读的通知数
+type NotificationUnread struct {
+	MemberId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Count int
+}
+
+func (Notification) TableName() string {
+	return "comment_notification"
+}
+
+func (NotificationActor) TableName() string {
+	return "comment_notification_actor"
+}
+
+func (NotificationUnread) TableName() string {
+	return "comment_notification_unread"
+}
+
+type notificationRepo struct {
+	data *Data
+	log *log.Helper
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		data: data,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (n notificationRepo) CreateNotification(ctx context.Context, notification *biz.Notification) error {
+	return n.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createNotification(tx, notification)
+	})
+}
+
+// MergeNotification 锁定同一评论同类型的未读通知, 新的触发人计数并成为最近的触发人
+// 同一触发人可能已有一条已读的通知, 合并时不能修改唯一索引中的 actor_id
+func (n notificationRepo) MergeNotification(ctx context.Context, notification *biz.Notification) error {
+	return n.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var exist Notification
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("member_id = ? AND type = ? AND comment_id = ? AND read_at IS NULL",
+				notification.MemberId, notification.Type, notification.CommentId).
+			Limit(1).
+			Find(&exist)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return createNotification(tx, notification)
+		}
+		result = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&NotificationActor{
+			NotificationId: exist.Id,
+			ActorId: notification.ActorId,
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return tx.Model(&exist).Updates(mergeNotificationFields(notification.ActorId)).Error
+	})
+}
+
+// mergeNotificationFields 合并新的触发人时更新的字段
+func mergeNotificationFields(actorId uint64) orm.UpdateFields {
+	return orm.UpdateFields{
+		"latest_actor_id": actorId,
+		"actor_count": gorm.Expr("actor_count + 1"),
+	}
+}
+
+// createNotification 新增未读通知与触发人, 并增加成员的未读数
+// 事件重复投递时通知已存在, 不再增加未读数
+func createNotification(tx *gorm.DB, notification *biz.Notification) error {
+	item := &Notification{
+		Id: orm.NextId(),
+		MemberId: notification.MemberId,
+		Type: notification.Type,
+		CommentId: notification.CommentId,
+		ObjId: notification.ObjId,
+		ObjType: notification.ObjType,
+		ActorId: notification.ActorId,
+		LatestActorId: notification.ActorId,
+		ActorCount: 1,
+	}
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(item)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return result.Error
+	}
+	err := tx.Create(&NotificationActor{
+		NotificationId: item.Id,
+		ActorId: notification.ActorId,
+	}).Error
+	if err != nil {
+		return err
+	}
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{"count": gorm.Expr("count + 1")}),
+	}).Create(&NotificationUnread{
+		MemberId: notification.MemberId,
+		Count: 1,
+	}).Error
+}
@@ -0,0 +1,50 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+// notificationSource 通知去重唯一索引的列
+type notificationSource struct {
+	memberId uint64
+	typ int8
+	commentId uint64
+	actorId uint64
+}
+
+// applyFields 按更新的列修改内存中的通知
+func applyFields(t *testing.T, n *Notification, actorId uint64) {
+	for column := range mergeNotificationFields(actorId) {
+		switch column {
+		case "actor_id":
+			n.ActorId = actorId
+		case "latest_actor_id":
+			n.LatestActorId = actorId
+		case "actor_count":
+			n.ActorCount++
+		default:
+			t.Fatalf("unexpected column %s", column)
+		}
+	}
+}
+
+func TestMergeNotificationWithReadNotification(t *testing.T) {
+	readAt := time.Now()
+	// 成员 2 的点赞通知已读, 成员 3 点赞后产生新的未读通知
+	read := &Notification{Id: 1, MemberId: 1, Type: 1, CommentId: 10, ActorId: 2, LatestActorId: 2, ActorCount: 1, ReadAt: &readAt}
+	unread := &Notification{Id: 2, MemberId: 1, Type: 1, CommentId: 10, ActorId: 3, LatestActorId: 3, ActorCount: 1}
+	// 成员 2 取消后再次点赞, 合并进未读通知
+	applyFields(t, unread, 2)
+	sources := make(map[notificationSource]uint64)
+	for _, n := range []*Notification{read, unread} {
+		source := notificationSource{n.MemberId, n.Type, n.CommentId, n.ActorId}
+		if id, ok := sources[source]; ok {
+			t.Fatalf("notification %d and %d violate idx_notification_source", id, n.Id)
+		}
+		sources[source] = n.Id
+	}
+	if unread.LatestActorId != 2 || unread.ActorCount != 2 {
+		t.Errorf("latest actor %d count %d, want 2 2", unread.LatestActorId, unread.ActorCount)
+	}
+}
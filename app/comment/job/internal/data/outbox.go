@@ -0,0 +1,151 @@
+package data
+
+import (
+	jobV1 "base-service/api/comment/job/v1"
+	"base-service/app/comment/job/internal/conf"
+	"base-service/pkg/orm"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+)
+
+// CommentEventTopic 评论领域事件的主题
+const CommentEventTopic = "comment-event"
+
+// CommentOutbox 待投递的评论事件, 与数据变更在同一事务中写入, 投递后删除
+type CommentOutbox struct {
+	Id uint64 `gorm:"primarykey;autoIncrement:false"`
+	Topic string `gorm:"size:128"`
+	Key string `gorm:"size:255"`
+	Payload []byte `gorm:"type:mediumblob"` // 事件内容, protobuf 编码
+	CreatedAt time.Time
+}
+
+func (CommentOutbox) TableName() string {
+	return "comment_outbox"
+}
+
+// saveCommentEvent 在事务中写入评论事件, key 为评论所属主题
+func saveCommentEvent(tx *gorm.DB, objId uint64, objType int, event *jobV1.CommentEvent) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&CommentOutbox{
+		Id: orm.NextId(),
+		Topic: CommentEventTopic,
+		Key: fmt.Sprintf("%d:%d", objId, objType),
+		Payload: payload,
+	}).Error
+}
+
+// outboxRelayLockTTL 投递一批事件的租约时长, 租约内其他实例不会投递
+const outboxRelayLockTTL = 30 * time.Second
+
+// outboxRelay 按写入顺序将 comment_outbox 中的事件投递到队列, 投递成功后删除
+// 多个 job 实例通过 redis 租约串行投递以保持同一主题的事件顺序, 发送时不持有数据库锁, 不阻塞事件写入
+// 发送后删除前进程退出或租约过期时事件会被再次投递, 消费方需要保证幂等
+type outboxRelay struct {
+	db *gorm.DB
+	queue Queue
+	lock *redisLock
+	interval time.Duration
+	batchSize int
+	stop chan struct{}
+	done chan struct{}
+	log *log.Helper
+}
+
+func newOutboxRelay(db *gorm.DB, rdb *redis.Client, queue Queue, c *conf.Data_Outbox, logger log.Logger) *outboxRelay {
+	r := &outboxRelay{
+		db: db,
+		queue: queue,
+		lock: newRedisLock(rdb, "comment:outbox:relay", outboxRelayLockTTL),
+		interval: c.GetPollInterval().AsDuration(),
+		batchSize: int(c.GetBatchSize()),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+		log: log.NewHelper(logger),
+	}
+	if r.interval <= 0 {
+		r.interval = time.Second
+	}
+	if r.batchSize <= 0 {
+		r.batchSize = 100
+	}
+	return r
+}
+
+func (r *outboxRelay) run() {
+	defer close(r.done)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-timer.C:
+		}
+		n, err := r.relay()
+		if err != nil {
+			r.log.Errorf("relay comment outbox err: %v", err)
+		}
+		// 投递满一批说明还有积压, 立即继续
+		if err == nil && n == r.batchSize {
+			timer.Reset(0)
+		} else {
+			timer.Reset(r.interval)
+		}
+	}
+}
+
+// close 等待正在投递的一批事件完成
+func (r *outboxRelay) close() {
+	close(r.stop)
+	<-r.done
+}
+
+// relay 投递一批事件, 返回投递成功的数量, 其他实例正在投递时跳过
+func (r *outboxRelay) relay() (int, error) {
+	ctx := context.Background()
+	locked, err := r.lock.TryLock(ctx)
+	if err != nil || !locked {
+		return 0, err
+	}
+	defer func() {
+		if err := r.lock.Unlock(ctx); err != nil {
+			r.log.Errorf("unlock comment outbox relay err: %v", err)
+		}
+	}()
+	var events []CommentOutbox
+	result := r.db.WithContext(ctx).
+		Order("id").
+		Limit(r.batchSize).
+		Find(&events)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	var sent []uint64
+	var sendErr error
+	for _, event := range events {
+		sendErr = r.queue.Send(event.Topic, event.Key, string(event.Payload), map[string]string{
+			SchemaVersionHeader: strconv.Itoa(int(jobV1.SchemaVersion_SCHEMA_VERSION_V1)),
+		})
+		if sendErr != nil {
+			break
+		}
+		sent = append(sent, event.Id)
+	}
+	if len(sent) == 0 {
+		return 0, sendErr
+	}
+	if err = r.db.WithContext(ctx).Delete(&CommentOutbox{}, sent).Error; err != nil {
+		return 0, err
+	}
+	return len(sent), sendErr
+}
@@ -1,69 +1,194 @@
 package data
 
 import (
+	jobV1 "base-service/api/comment/job/v1"
+	"base-service/app/comment/job/internal/conf"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/Shopify/sarama"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"sync"
+	"time"
 )
 
 var _ Queue = (*Kafka)(nil)
 
+// 处理失败后重试的间隔, 每次翻倍直到上限
+const (
+	handlerRetryBackoff = time.Second
+	handlerRetryMaxBackoff = 30 * time.Second
+	handlerMaxRetries = 3
+	handlerWorkers = 8
+)
+
+// DeadLetterSuffix 死信主题的后缀, 重试后仍处理失败的消息写入 <topic>.dlq
+const DeadLetterSuffix = ".dlq"
+
+// SchemaVersionHeader 消息 schema 版本的 header, 值见 api/comment/job/v1 SchemaVersion
+const SchemaVersionHeader = "schema-version"
+
+// DeadLetterMessage 死信主题中的消息, 记录原消息及失败原因
+type DeadLetterMessage struct {
+	Topic string
+	Partition int32
+	Offset int64
+	Key string
+	Headers map[string]string
+	Value []byte
+	Reason string
+	Attempts int
+	FailedAt time.Time
+}
+
+// permanentError 重试也无法成功的错误, 如消息格式错误
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+// Permanent 标记错误不需要重试, 消息直接写入死信主题
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+func isPermanent(err error) bool {
+	_, ok := err.(permanentError)
+	return ok
+}
+
 type Message struct {
+	Key      string
+	Headers  map[string]string
 	Value    []byte
 	Topic    string
+	Partition int32
+	Offset   int64
 }
 
 type Queue interface {
-	Send(topic string, value string) error
-	SubscribeChan(topic string, chanSize int) (Consumer, error)
+	// Send 发送消息, key 相同的消息写入同一分区并按顺序消费, key 为空时随机分区
+	Send(topic string, key string, value string, headers map[string]string) error
+	// Subscribe 以消费组订阅主题, handler 返回 nil 或消息写入死信主题后才提交该消息的位移
 	Subscribe(topic string, handler Handler) error
+	// Start 开始消费已订阅的主题, 之后不能再订阅
+	Start() error
 	close()
 }
-type Handler func(context.Context, *Message)
 
-type Consumer interface {
-	Receive() <-chan *Message
+// Handler 处理一条消息, 返回错误时按退避重试, 重试次数用尽或返回 Permanent 错误时写入死信主题
+// 不需要进入死信主题的消息应记录日志后返回 nil
+type Handler func(context.Context, *Message) error
+
+// errQueueClosed 队列已关闭, 不再接收消息
+var errQueueClosed = errors.New("queue is closed")
+
+// NewQueue 按 driver 创建队列, 默认使用 kafka, memory 为进程内队列, 用于本地开发与集成测试
+func NewQueue(c *conf.Data_Kafka, logger log.Logger) (Queue, error) {
+	switch c.GetDriver() {
+	case "", "kafka":
+		return NewKafka(c, logger)
+	case "memory":
+		return NewMemory(c, logger), nil
+	}
+	return nil, fmt.Errorf("unknown queue driver %q", c.GetDriver())
 }
 
-type consumer struct {
-	message chan *Message
+// retryPolicy 消息处理失败后的重试策略
+type retryPolicy struct {
+	maxRetries int
+	backoff time.Duration
+}
+
+func newRetryPolicy(c *conf.Data_Kafka) retryPolicy {
+	policy := retryPolicy{
+		maxRetries: int(c.GetMaxRetries()),
+		backoff: c.GetRetryBackoff().AsDuration(),
+	}
+	if policy.maxRetries <= 0 {
+		policy.maxRetries = handlerMaxRetries
+	}
+	if policy.backoff <= 0 {
+		policy.backoff = handlerRetryBackoff
+	}
+	return policy
 }
 
 type Kafka struct {
-	consumer sarama.Consumer
+	address []string
+	groupId string
+	policy retryPolicy
+	workers int
+	config *sarama.Config
 	producer sarama.SyncProducer
+	handlers map[string]Handler
+	group sarama.ConsumerGroup
+	ctx context.Context
+	cancel context.CancelFunc
+	wg sync.WaitGroup
+	mu sync.Mutex
 	log      log.Logger
 }
 
-func NewKafka(address []string, logger log.Logger) (*Kafka, error) {
+func NewKafka(c *conf.Data_Kafka, logger log.Logger) (*Kafka, error) {
 	config := sarama.NewConfig()
 	config.Producer.RequiredAcks = sarama.WaitForAll
-	config.Producer.Partitioner = sarama.NewRandomPartitioner
+	config.Producer.Partitioner = sarama.NewHashPartitioner
 	config.Producer.Return.Successes = true
 	config.Producer.Return.Errors = true
+	// 消费组第一次启动时按配置从最新或最早的消息开始, 之后从提交的位移继续
+	switch c.GetInitialOffset() {
+	case "", "newest":
+		config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	case "oldest":
+		config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	default:
+		return nil, fmt.Errorf("unknown kafka initial_offset %q", c.GetInitialOffset())
+	}
+	config.Consumer.Group.Rebalance.Strategy = sarama.BalanceStrategyRange
 
 	// producer
-	producer, err := sarama.NewSyncProducer(address, config)
+	producer, err := sarama.NewSyncProducer(c.Addr, config)
 	if err != nil {
 		_ = logger.Log(log.LevelFatal, "producer_test create producer error ", err.Error())
 		return nil, err
 	}
-
-	// consumer
-	consumer, err := sarama.NewConsumer(address, nil)
-	if err != nil {
-		_ = logger.Log(log.LevelFatal, "producer_test create producer error ", err.Error())
-		return nil, err
+	workers := int(c.Workers)
+	if workers <= 0 {
+		workers = handlerWorkers
 	}
-	return &Kafka{consumer: consumer, producer: producer, log: logger}, nil
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Kafka{
+		address: c.Addr,
+		groupId: c.GroupId,
+		policy: newRetryPolicy(c),
+		workers: workers,
+		config: config,
+		producer: producer,
+		handlers: make(map[string]Handler),
+		ctx: ctx,
+		cancel: cancel,
+		log: logger,
+	}, nil
 }
 
-func (k *Kafka) Send(topic string, value string) error {
+func (k *Kafka) Send(topic string, key string, value string, headers map[string]string) error {
 	msg := &sarama.ProducerMessage{
 		Topic: topic,
 		Value: sarama.StringEncoder(value),
 	}
+	if key != "" {
+		msg.Key = sarama.StringEncoder(key)
+	}
+	for name, v := range headers {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte(name), Value: []byte(v)})
+	}
 	_, _, err := k.producer.SendMessage(msg)
 	if err != nil {
 		return err
@@ -71,62 +196,224 @@ func (k *Kafka) Send(topic string, value string) error {
 	return nil
 }
 
-func (k *Kafka) Subscribe(topic string,handler Handler) error {
-	partitionList, err := k.consumer.Partitions(topic)
+// Subscribe 记录主题的处理函数, 由 Start 统一加入消费组
+func (k *Kafka) Subscribe(topic string, handler Handler) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.group != nil {
+		return fmt.Errorf("subscribe %s after kafka consumer started", topic)
+	}
+	k.handlers[topic] = handler
+	return nil
+}
+
+// Start 以一个消费组成员消费所有订阅的主题, 重平衡后重新加入消费组, 直到 close
+func (k *Kafka) Start() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if len(k.handlers) == 0 || k.group != nil {
+		return nil
+	}
+	if k.groupId == "" {
+		return errors.New("kafka group_id is not configured")
+	}
+	group, err := sarama.NewConsumerGroup(k.address, k.groupId, k.config)
 	if err != nil {
-		_ = k.log.Log(log.LevelInfo, fmt.Sprintf("fail to get list of partition: %v", err))
+		_ = k.log.Log(log.LevelError, "msg", fmt.Sprintf("failed to create consumer group %s: %v", k.groupId, err))
 		return err
 	}
-	for partition := range partitionList {
-		pc, err := k.consumer.ConsumePartition(topic, int32(partition), sarama.OffsetNewest)
-		if err != nil {
-			_ = k.log.Log(log.LevelInfo, fmt.Sprintf("failed to start consumer for partition %d,err:%v\n", partition, err))
-			return err
-		}
-		go func(pc sarama.PartitionConsumer) {
-			for msg := range pc.Messages() {
-				handler(context.Background(),&Message{
-					Value:    msg.Value,
-					Topic:    msg.Topic,
-				})
+	k.group = group
+	topics := make([]string, 0, len(k.handlers))
+	h := &groupHandler{
+		kafka: k,
+		handlers: k.handlers,
+		workers: make(map[string]chan struct{}, len(k.handlers)),
+		log: k.log,
+	}
+	for topic := range k.handlers {
+		topics = append(topics, topic)
+		h.workers[topic] = make(chan struct{}, k.workers)
+	}
+	k.wg.Add(1)
+	go func() {
+		defer k.wg.Done()
+		for {
+			// 发生重平衡时 Consume 返回, 重新加入后从已提交的位移继续消费
+			if err := group.Consume(k.ctx, topics, h); err != nil {
+				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+					return
+				}
+				_ = k.log.Log(log.LevelError, "msg", fmt.Sprintf("consume %v err: %v", topics, err))
+				time.Sleep(handlerRetryBackoff)
+			}
+			if k.ctx.Err() != nil {
+				return
 			}
-			defer pc.AsyncClose()
-		}(pc)
+		}
+	}()
+	return nil
+}
+
+// groupHandler 按主题分发消息, 按分区顺序处理, 处理成功后标记位移, 由 sarama 定时提交
+// 每个分区由独立的协程消费, 每个主题同时处理消息的分区数不超过 workers 的容量
+type groupHandler struct {
+	kafka *Kafka
+	handlers map[string]Handler
+	workers map[string]chan struct{}
+	log log.Logger
+}
+
+func (h *groupHandler) Setup(session sarama.ConsumerGroupSession) error {
+	_ = h.log.Log(log.LevelInfo, "msg", "consumer group session setup", "claims", fmt.Sprint(session.Claims()))
+	return nil
+}
+
+func (h *groupHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	_ = h.log.Log(log.LevelInfo, "msg", "consumer group session cleanup", "claims", fmt.Sprint(session.Claims()))
+	return nil
+}
+
+func (h *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	workers := h.workers[claim.Topic()]
+	for msg := range claim.Messages() {
+		select {
+		case workers <- struct{}{}:
+		case <-session.Context().Done():
+			return nil
+		}
+		ok := h.handle(session, msg)
+		<-workers
+		if !ok {
+			// 会话结束时分区可能已分配给其他成员, 未提交的消息由新的成员重新处理
+			return nil
+		}
+		session.MarkMessage(msg, "")
 	}
 	return nil
 }
 
-func (k *Kafka) SubscribeChan(topic string, chanSize int) (Consumer, error) {
-	c := &consumer{message: make(chan *Message, chanSize)}
-	partitionList, err := k.consumer.Partitions(topic)
+// handle 会话结束时返回 false
+func (h *groupHandler) handle(session sarama.ConsumerGroupSession, msg *sarama.ConsumerMessage) bool {
+	return deliver(session.Context(), h.kafka, h.kafka.policy, h.handlers[msg.Topic], newMessage(msg), h.log)
+}
+
+// deliver 处理失败时退避重试, 保证同一分区内的顺序, 重试次数用尽后写入死信主题
+// ctx 结束时返回 false, 消息未处理完成
+func deliver(ctx context.Context, q Queue, policy retryPolicy, handler Handler, msg *Message, logger log.Logger) bool {
+	backoff := policy.backoff
+	attempts := 0
+	for {
+		err := handler(ctx, msg)
+		if err == nil {
+			return true
+		}
+		attempts++
+		if isPermanent(err) || attempts > policy.maxRetries {
+			return deadLetter(ctx, q, msg, err, attempts, logger)
+		}
+		_ = logger.Log(log.LevelError, "msg", fmt.Sprintf("handle message %s/%d/%d err: %v, retry after %s",
+			msg.Topic, msg.Partition, msg.Offset, err, backoff))
+		if !sleep(ctx, backoff) {
+			return false
+		}
+		if backoff *= 2; backoff > handlerRetryMaxBackoff {
+			backoff = handlerRetryMaxBackoff
+		}
+	}
+}
+
+// deadLetter 将消息写入死信主题, 写入失败时持续重试, 不丢弃消息
+// 队列已关闭时返回 false, 不提交位移, 重启后重新投递
+func deadLetter(ctx context.Context, q Queue, msg *Message, reason error, attempts int, logger log.Logger) bool {
+	value, err := json.Marshal(DeadLetterMessage{
+		Topic: msg.Topic,
+		Partition: msg.Partition,
+		Offset: msg.Offset,
+		Key: msg.Key,
+		Headers: msg.Headers,
+		Value: msg.Value,
+		Reason: reason.Error(),
+		Attempts: attempts,
+		FailedAt: time.Now(),
+	})
 	if err != nil {
-		_ = k.log.Log(log.LevelInfo, fmt.Sprintf("fail to get list of partition: %v", err))
-		return nil, err
+		_ = logger.Log(log.LevelError, "msg", fmt.Sprintf("marshal dead letter %s/%d/%d err: %v", msg.Topic, msg.Partition, msg.Offset, err))
+		return false
 	}
-	for partition := range partitionList {
-		pc, err := k.consumer.ConsumePartition(topic, int32(partition), sarama.OffsetNewest)
-		if err != nil {
-			_ = k.log.Log(log.LevelInfo, fmt.Sprintf("failed to start consumer for partition %d,err:%v\n", partition, err))
-			return nil, err
+	_ = logger.Log(log.LevelError, "msg", fmt.Sprintf("message %s/%d/%d failed after %d attempts: %v",
+		msg.Topic, msg.Partition, msg.Offset, attempts, reason))
+	for {
+		err = q.Send(msg.Topic + DeadLetterSuffix, msg.Key, string(value), nil)
+		if err == nil {
+			return true
+		}
+		_ = logger.Log(log.LevelError, "msg", fmt.Sprintf("send dead letter %s/%d/%d err: %v", msg.Topic, msg.Partition, msg.Offset, err))
+		if errors.Is(err, errQueueClosed) {
+			return false
+		}
+		if !sleep(ctx, handlerRetryMaxBackoff) {
+			return false
 		}
-		go func(pc sarama.PartitionConsumer) {
-			for msg := range pc.Messages() {
-				c.message <- &Message{
-					Value:    msg.Value,
-					Topic:    msg.Topic,
-				}
-			}
-			defer pc.AsyncClose()
-		}(pc)
 	}
-	return c, nil
 }
 
-func (c *consumer) Receive() <-chan *Message {
-	return c.message
+// SendProto 以 protobuf 编码发送消息, 并在 header 中写入当前的 schema 版本
+func SendProto(q Queue, topic string, key string, m proto.Message) error {
+	value, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return q.Send(topic, key, string(value), map[string]string{
+		SchemaVersionHeader: strconv.Itoa(int(jobV1.SchemaVersion_SCHEMA_VERSION_V1)),
+	})
+}
+
+// UnmarshalProto 校验 schema 版本后解码消息, 版本未知或解码失败时返回 Permanent 错误
+func UnmarshalProto(msg *Message, m proto.Message) error {
+	version := msg.Headers[SchemaVersionHeader]
+	if version != strconv.Itoa(int(jobV1.SchemaVersion_SCHEMA_VERSION_V1)) {
+		return Permanent(fmt.Errorf("unknown schema version %q of topic %s", version, msg.Topic))
+	}
+	if err := proto.Unmarshal(msg.Value, m); err != nil {
+		return Permanent(err)
+	}
+	return nil
+}
+
+func newMessage(msg *sarama.ConsumerMessage) *Message {
+	m := &Message{
+		Key:      string(msg.Key),
+		Value:    msg.Value,
+		Topic:    msg.Topic,
+		Partition: msg.Partition,
+		Offset:   msg.Offset,
+	}
+	if len(msg.Headers) > 0 {
+		m.Headers = make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			m.Headers[string(header.Key)] = string(header.Value)
+		}
+	}
+	return m
+}
+
+// sleep 等待指定时间, ctx 结束时返回 false
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
 }
 
 func (k *Kafka) close() {
+	k.cancel()
+	k.mu.Lock()
+	if k.group != nil {
+		_ = k.group.Close()
+	}
+	k.mu.Unlock()
+	k.wg.Wait()
 	_ = k.producer.Close()
-	_ = k.consumer.Close()
-}
\ No newline at end of file
+}
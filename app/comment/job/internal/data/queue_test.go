@@ -0,0 +1,99 @@
+package data
+
+import (
+	jobV1 "base-service/api/comment/job/v1"
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"testing"
+)
+
+// recordQueue 记录发送的消息
+type recordQueue struct {
+	Queue
+	messages []*Message
+}
+
+func (q *recordQueue) Send(topic string, key string, value string, headers map[string]string) error {
+	q.messages = append(q.messages, &Message{Topic: topic, Key: key, Value: []byte(value), Headers: headers})
+	return nil
+}
+
+func TestSendProto(t *testing.T) {
+	q := &recordQueue{}
+	sent := &jobV1.CommentScoreCacheMessage{ObjId: 1, ObjType: 2}
+	if err := SendProto(q, "comment-score-cache", "1:2", sent); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.messages) != 1 {
+		t.Fatalf("sent %d messages", len(q.messages))
+	}
+	msg := q.messages[0]
+	if msg.Key != "1:2" || msg.Topic != "comment-score-cache" {
+		t.Errorf("key = %q, topic = %q", msg.Key, msg.Topic)
+	}
+	var got jobV1.CommentScoreCacheMessage
+	if err := UnmarshalProto(msg, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(&got, sent) {
+		t.Errorf("got %v, want %v", &got, sent)
+	}
+}
+
+func TestUnmarshalProto(t *testing.T) {
+	value, _ := proto.Marshal(&jobV1.CommentEvent{Event: &jobV1.CommentEvent_Deleted{Deleted: &jobV1.CommentDeleted{CommentId: 3}}})
+	current := strconv.Itoa(int(jobV1.SchemaVersion_SCHEMA_VERSION_V1))
+	tests := []struct {
+		name string
+		headers map[string]string
+		value []byte
+		wantErr bool
+	}{
+		{"current version", map[string]string{SchemaVersionHeader: current}, value, false},
+		{"missing version", nil, value, true},
+		{"unspecified version", map[string]string{SchemaVersionHeader: "0"}, value, true},
+		{"newer version", map[string]string{SchemaVersionHeader: "999"}, value, true},
+		{"malformed payload", map[string]string{SchemaVersionHeader: current}, []byte{0xff, 0xff}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var event jobV1.CommentEvent
+			err := UnmarshalProto(&Message{Topic: CommentEventTopic, Headers: tt.headers, Value: tt.value}, &event)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want err %v", err, tt.wantErr)
+			}
+			if err != nil {
+				// 无法处理的消息不重试, 直接进入死信主题
+				if !isPermanent(err) {
+					t.Errorf("err %v is not permanent", err)
+				}
+				return
+			}
+			if event.GetDeleted().GetCommentId() != 3 {
+				t.Errorf("event = %v", &event)
+			}
+		})
+	}
+}
+
+// closedQueue 已关闭的队列, 发送消息返回 errQueueClosed
+type closedQueue struct {
+	Queue
+}
+
+func (closedQueue) Send(string, string, string, map[string]string) error {
+	return errQueueClosed
+}
+
+func TestDeliverDeadLetterQueueClosed(t *testing.T) {
+	handler := func(context.Context, *Message) error {
+		return Permanent(errors.New("bad message"))
+	}
+	msg := &Message{Topic: "topic", Partition: 1, Offset: 10}
+	if deliver(context.Background(), closedQueue{}, retryPolicy{}, handler, msg, log.DefaultLogger) {
+		t.Errorf("deliver() = true, want false so that the offset is not committed")
+	}
+}
@@ -0,0 +1,386 @@
+package data
+
+import (
+	"base-service/app/comment/job/internal/biz"
+	"base-service/pkg/orm"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"strings"
+	"time"
+)
+
+// counter 一个计数字段存储的值与重新计算的值
+type counter struct {
+	field string
+	stored int
+	actual int
+}
+
+// reconcileBatchSize 每批比较的评论数
+const reconcileBatchSize = 500
+
+// reactionCountField comment_reaction_count 计数不一致时 CounterDrift.Field 的前缀, 后接表态类型
+const reactionCountField = "reaction_count:"
+
+// CommentReactionCount 评论各表态类型的计数, 表由评论服务维护
+type CommentReactionCount struct {
+	CommentId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Reaction string `gorm:"primaryKey;size:32"`
+	Count int
+}
+
+func (CommentReactionCount) TableName() string {
+	return "comment_reaction_count"
+}
+
+type reconcileRepo struct {
+	data *Data
+	log *log.Helper
+}
+
+func NewReconcileRepo(data *Data, logger log.Logger) biz.ReconcileRepo {
+	return &reconcileRepo{
+		data: data,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (r *reconcileRepo) GetSubjectId(ctx context.Context, objId uint64, objType int) (uint64, error) {
+	var ids []uint64
+	result := r.data.db.WithContext(ctx).Model(&CommentSubject{}).
+		Where("obj_id = ? AND obj_type = ?", objId, objType).
+		Limit(1).
+		Pluck("id", &ids)
+	if result.Error != nil || len(ids) == 0 {
+		return 0, result.Error
+	}
+	return ids[0], nil
+}
+
+func (r *reconcileRepo) ListSubjectIds(ctx context.Context, afterId uint64, limit int) ([]uint64, error) {
+	var ids []uint64
+	result := r.data.db.WithContext(ctx).Model(&CommentSubject{}).
+		Where("id > ?", afterId).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids)
+	return ids, result.Error
+}
+
+// TryLockReconcile 获取定时校正的租约, 租约到期前其他实例不会校正
+func (r *reconcileRepo) TryLockReconcile(ctx context.Context, ttl time.Duration) (bool, error) {
+	return newRedisLock(r.data.redisDB, "comment:reconcile", ttl).TryLock(ctx)
+}
+
+// ReconcileSubject 主题的计数为可见评论数, 根评论的计数为可见回复数, like、hate 与 comment_reaction_count 为对应表态的成员数
+// 先不加锁聚合统计找出不一致的计数, 再逐行在短事务中加锁重新统计后修正, 不会覆盖统计期间的写入
+// 按主题的查询使用评论服务创建的 idx_comment_index_subject (subject_id, root, floor) 索引
+func (r *reconcileRepo) ReconcileSubject(ctx context.Context, subjectId uint64, dryRun bool) ([]*biz.CounterDrift, error) {
+	db := r.data.db.WithContext(ctx)
+	var subject CommentSubject
+	if err := db.First(&subject, subjectId).Error; err != nil {
+		return nil, err
+	}
+	replies, err := countVisible(db, subjectId, 0)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := countReactions(db, subjectId, 0)
+	if err != nil {
+		return nil, err
+	}
+	drifts := subjectDrifts(&subject, replies)
+	// 按id分批比较评论存储的计数, 不一次加载主题下的所有评论
+	var afterId uint64
+	for {
+		var indexList []*CommentIndex
+		result := db.Select("id", "subject_id", "root", "count", "root_count", "like", "hate").
+			Where("subject_id = ? AND id > ?", subjectId, afterId).
+			Order("id").
+			Limit(reconcileBatchSize).
+			Find(&indexList)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		ids := make([]uint64, len(indexList))
+		for i, ci := range indexList {
+			ids[i] = ci.Id
+		}
+		stored, err := listReactionCounts(db, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, ci := range indexList {
+			drifts = append(drifts, commentDrifts(ci, replies, reactions)...)
+			drifts = append(drifts, reactionCountDrifts(ci.SubjectId, ci.Id, stored[ci.Id], reactions[ci.Id])...)
+		}
+		if len(indexList) < reconcileBatchSize {
+			break
+		}
+		afterId = indexList[len(indexList) - 1].Id
+	}
+	if dryRun || len(drifts) == 0 {
+		return drifts, nil
+	}
+	var fixed []*biz.CounterDrift
+	for _, commentId := range driftedComments(drifts) {
+		var result []*biz.CounterDrift
+		if commentId == 0 {
+			result, err = r.fixSubject(ctx, subjectId)
+		} else {
+			result, err = r.fixComment(ctx, commentId)
+		}
+		if err != nil {
+			return fixed, err
+		}
+		fixed = append(fixed, result...)
+	}
+	return fixed, nil
+}
+
+// fixSubject 锁定主题后重新统计并修正主题的计数
+// 加锁后的第一次一致性读才建立快照, 统计包含锁定前已提交的评论, 未提交的评论等待主题锁后在修正值上增减
+func (r *reconcileRepo) fixSubject(ctx context.Context, subjectId uint64) ([]*biz.CounterDrift, error) {
+	var drifts []*biz.CounterDrift
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var subject CommentSubject
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&subject, subjectId)
+		if result.Error != nil {
+			return result.Error
+		}
+		replies, err := countVisible(tx, subjectId, 0)
+		if err != nil {
+			return err
+		}
+		drifts = subjectDrifts(&subject, replies)
+		if len(drifts) == 0 {
+			return nil
+		}
+		return tx.Model(&subject).Updates(driftFields(drifts)).Error
+	})
+	return drifts, err
+}
+
+// fixComment 锁定评论后重新统计并修正评论的计数, 再修正评论的各表态计数
+func (r *reconcileRepo) fixComment(ctx context.Context, commentId uint64) ([]*biz.CounterDrift, error) {
+	var drifts []*biz.CounterDrift
+	var ci CommentIndex
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "subject_id", "root", "count", "root_count", "like", "hate").
+			First(&ci, commentId)
+		if result.Error != nil {
+			return result.Error
+		}
+		replies := map[uint64]int{}
+		if ci.Root == 0 {
+			var err error
+			if replies, err = countVisible(tx, ci.SubjectId, ci.Id); err != nil {
+				return err
+			}
+		}
+		reactions, err := countReactions(tx, ci.SubjectId, ci.Id)
+		if err != nil {
+			return err
+		}
+		drifts = commentDrifts(&ci, replies, reactions)
+		if len(drifts) == 0 {
+			return nil
+		}
+		return tx.Model(&ci).Updates(driftFields(drifts)).Error
+	})
+	if err != nil {
+		return drifts, err
+	}
+	countDrifts, err := r.fixReactionCount(ctx, ci.SubjectId, commentId)
+	return append(drifts, countDrifts...), err
+}
+
+// fixReactionCount 锁定评论的表态计数行后重新统计并修正
+// 与更新表态一样先锁定计数行再锁定评论, 不在持有评论锁时加锁, 避免死锁
+func (r *reconcileRepo) fixReactionCount(ctx context.Context, subjectId, commentId uint64) ([]*biz.CounterDrift, error) {
+	var drifts []*biz.CounterDrift
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var countList []*CommentReactionCount
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("comment_id = ?", commentId).
+			Find(&countList)
+		if result.Error != nil {
+			return result.Error
+		}
+		stored := make(map[string]int, len(countList))
+		for _, item := range countList {
+			stored[item.Reaction] = item.Count
+		}
+		reactions, err := countReactions(tx, subjectId, commentId)
+		if err != nil {
+			return err
+		}
+		drifts = reactionCountDrifts(subjectId, commentId, stored, reactions[commentId])
+		for _, drift := range drifts {
+			err = tx.Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"count"})}).
+				Create(&CommentReactionCount{
+					CommentId: commentId,
+					Reaction: strings.TrimPrefix(drift.Field, reactionCountField),
+					Count: drift.Actual,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return drifts, err
+}
+
+// countVisible 按根评论统计主题下的可见评论数, 键 0 为可见的根评论数, root 不为 0 时只统计该根评论的回复
+func countVisible(tx *gorm.DB, subjectId, root uint64) (map[uint64]int, error) {
+	var rows []struct {
+		Root uint64
+		Count int
+	}
+	query := tx.Model(&CommentIndex{}).
+		Select("root, COUNT(*) AS count").
+		Where("subject_id = ? AND state IN ?", subjectId, biz.CommentVisibleStates)
+	if root != 0 {
+		query = query.Where("root = ?", root)
+	}
+	if err := query.Group("root").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	replies := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		replies[row.Root] = row.Count
+	}
+	return replies, nil
+}
+
+// countReactions 统计主题下各评论每种表态的成员数, commentId 不为 0 时只统计该评论
+func countReactions(tx *gorm.DB, subjectId, commentId uint64) (map[uint64]map[string]int, error) {
+	var rows []struct {
+		CommentId uint64
+		Reaction string
+		Count int
+	}
+	query := tx.Table("comment_reaction").
+		Select("comment_reaction.comment_id, comment_reaction.reaction, COUNT(*) AS count").
+		Joins("JOIN comment_index ON comment_index.id = comment_reaction.comment_id").
+		Where("comment_index.subject_id = ?", subjectId)
+	if commentId != 0 {
+		query = query.Where("comment_reaction.comment_id = ?", commentId)
+	}
+	if err := query.Group("comment_reaction.comment_id, comment_reaction.reaction").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	reactions := make(map[uint64]map[string]int)
+	for _, row := range rows {
+		if reactions[row.CommentId] == nil {
+			reactions[row.CommentId] = make(map[string]int)
+		}
+		reactions[row.CommentId][row.Reaction] = row.Count
+	}
+	return reactions, nil
+}
+
+// listReactionCounts 查询评论在 comment_reaction_count 中存储的各表态计数
+func listReactionCounts(tx *gorm.DB, commentIds []uint64) (map[uint64]map[string]int, error) {
+	var countList []*CommentReactionCount
+	if err := tx.Where("comment_id IN ?", commentIds).Find(&countList).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[uint64]map[string]int)
+	for _, item := range countList {
+		if counts[item.CommentId] == nil {
+			counts[item.CommentId] = make(map[string]int)
+		}
+		counts[item.CommentId][item.Reaction] = item.Count
+	}
+	return counts, nil
+}
+
+// subjectDrifts 比较主题存储的计数与按根评论统计的可见评论数
+func subjectDrifts(subject *CommentSubject, replies map[uint64]int) []*biz.CounterDrift {
+	count := 0
+	for _, n := range replies {
+		count += n
+	}
+	return compareCounters(subject.Id, 0, []counter{
+		{"count", subject.Count, count},
+		{"root_count", subject.RootCount, replies[0]},
+		{"all_count", subject.AllCount, count},
+	})
+}
+
+// commentDrifts 比较评论存储的计数与统计结果, 只有根评论统计回复数
+func commentDrifts(ci *CommentIndex, replies map[uint64]int, reactions map[uint64]map[string]int) []*biz.CounterDrift {
+	counters := []counter{
+		{"like", ci.Like, reactions[ci.Id][biz.ReactionLike]},
+		{"hate", ci.Hate, reactions[ci.Id][biz.ReactionDislike]},
+	}
+	if ci.Root == 0 {
+		counters = append(counters, counter{"count", ci.Count, replies[ci.Id]},
+			counter{"root_count", ci.RootCount, replies[ci.Id]})
+	}
+	return compareCounters(ci.SubjectId, ci.Id, counters)
+}
+
+// reactionCountDrifts 比较评论在 comment_reaction_count 中存储的计数与统计结果, 按表态类型排序
+func reactionCountDrifts(subjectId, commentId uint64, stored, actual map[string]int) []*biz.CounterDrift {
+	reactions := make([]string, 0, len(stored) + len(actual))
+	for reaction := range stored {
+		reactions = append(reactions, reaction)
+	}
+	for reaction := range actual {
+		if _, ok := stored[reaction]; !ok {
+			reactions = append(reactions, reaction)
+		}
+	}
+	sort.Strings(reactions)
+	counters := make([]counter, len(reactions))
+	for i, reaction := range reactions {
+		counters[i] = counter{reactionCountField + reaction, stored[reaction], actual[reaction]}
+	}
+	return compareCounters(subjectId, commentId, counters)
+}
+
+func compareCounters(subjectId, commentId uint64, counters []counter) []*biz.CounterDrift {
+	var drifts []*biz.CounterDrift
+	for _, c := range counters {
+		if c.stored == c.actual {
+			continue
+		}
+		drifts = append(drifts, &biz.CounterDrift{
+			SubjectId: subjectId,
+			CommentId: commentId,
+			Field: c.field,
+			Stored: c.stored,
+			Actual: c.actual,
+		})
+	}
+	return drifts
+}
+
+// driftFields 修正不一致计数的字段
+func driftFields(drifts []*biz.CounterDrift) orm.UpdateFields {
+	fields := orm.UpdateFields{}
+	for _, drift := range drifts {
+		fields[drift.Field] = drift.Actual
+	}
+	return fields
+}
+
+// driftedComments 按出现顺序返回计数不一致的评论, 0 表示主题
+func driftedComments(drifts []*biz.CounterDrift) []uint64 {
+	var ids []uint64
+	seen := make(map[uint64]bool)
+	for _, drift := range drifts {
+		if !seen[drift.CommentId] {
+			seen[drift.CommentId] = true
+			ids = append(ids, drift.CommentId)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"base-service/app/comment/job/internal/biz"
+	"base-service/pkg/orm"
+	"reflect"
+	"testing"
+)
+
+// driftValues 取出计数偏差的值便于比较
+func driftValues(drifts []*biz.CounterDrift) []biz.CounterDrift {
+	var result []biz.CounterDrift
+	for _, drift := range drifts {
+		result = append(result, *drift)
+	}
+	return result
+}
+
+func TestSubjectDrifts(t *testing.T) {
+	tests := []struct {
+		name string
+		subject CommentSubject
+		replies map[uint64]int
+		want []biz.CounterDrift
+	}{
+		{"empty", CommentSubject{Model: orm.Model{Id: 1}}, map[uint64]int{}, nil},
+		{"in sync", CommentSubject{Model: orm.Model{Id: 1}, Count: 5, RootCount: 2, AllCount: 5},
+			map[uint64]int{0: 2, 10: 3}, nil},
+		{"replies drifted", CommentSubject{Model: orm.Model{Id: 1}, Count: 4, RootCount: 2, AllCount: 4},
+			map[uint64]int{0: 2, 10: 1, 11: 2}, []biz.CounterDrift{
+				{SubjectId: 1, Field: "count", Stored: 4, Actual: 5},
+				{SubjectId: 1, Field: "all_count", Stored: 4, Actual: 5},
+			}},
+		{"negative root count", CommentSubject{Model: orm.Model{Id: 1}, Count: 1, RootCount: -1, AllCount: 1},
+			map[uint64]int{0: 1}, []biz.CounterDrift{
+				{SubjectId: 1, Field: "root_count", Stored: -1, Actual: 1},
+			}},
+	}
+	for _, tt := range tests {
+		got := driftValues(subjectDrifts(&tt.subject, tt.replies))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: drifts %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCommentDrifts(t *testing.T) {
+	replies := map[uint64]int{0: 2, 10: 3}
+	reactions := map[uint64]map[string]int{
+		10: {biz.ReactionLike: 4},
+		20: {biz.ReactionLike: 1, biz.ReactionDislike: 2, "laugh": 3},
+	}
+	tests := []struct {
+		name string
+		ci CommentIndex
+		want []biz.CounterDrift
+	}{
+		{"root in sync", CommentIndex{Model: orm.Model{Id: 10}, SubjectId: 1, Count: 3, RootCount: 3, Like: 4}, nil},
+		{"root replies drifted", CommentIndex{Model: orm.Model{Id: 10}, SubjectId: 1, Count: 2, RootCount: 3, Like: 4},
+			[]biz.CounterDrift{{SubjectId: 1, CommentId: 10, Field: "count", Stored: 2, Actual: 3}}},
+		{"root without replies", CommentIndex{Model: orm.Model{Id: 11}, SubjectId: 1, Count: 1, RootCount: 1},
+			[]biz.CounterDrift{
+				{SubjectId: 1, CommentId: 11, Field: "count", Stored: 1, Actual: 0},
+				{SubjectId: 1, CommentId: 11, Field: "root_count", Stored: 1, Actual: 0},
+			}},
+		{"reply reactions drifted", CommentIndex{Model: orm.Model{Id: 20}, SubjectId: 1, Root: 10, Like: 3, Hate: 2},
+			[]biz.CounterDrift{{SubjectId: 1, CommentId: 20, Field: "like", Stored: 3, Actual: 1}}},
+		{"reply count ignored", CommentIndex{Model: orm.Model{Id: 21}, SubjectId: 1, Root: 10, Count: 7}, nil},
+	}
+	for _, tt := range tests {
+		got := driftValues(commentDrifts(&tt.ci, replies, reactions))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: drifts %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReactionCountDrifts(t *testing.T) {
+	tests := []struct {
+		name string
+		stored map[string]int
+		actual map[string]int
+		want []biz.CounterDrift
+	}{
+		{"in sync", map[string]int{biz.ReactionLike: 2, "laugh": 1}, map[string]int{biz.ReactionLike: 2, "laugh": 1}, nil},
+		{"no reactions", nil, nil, nil},
+		{"cancelled reaction kept zero", map[string]int{"laugh": 0}, nil, nil},
+		{"drifted", map[string]int{biz.ReactionLike: 3, "laugh": 1}, map[string]int{biz.ReactionLike: 2, "laugh": 1},
+			[]biz.CounterDrift{{SubjectId: 1, CommentId: 10, Field: "reaction_count:like", Stored: 3, Actual: 2}}},
+		{"missing row", map[string]int{biz.ReactionLike: 1}, map[string]int{biz.ReactionLike: 1, "laugh": 2},
+			[]biz.CounterDrift{{SubjectId: 1, CommentId: 10, Field: "reaction_count:laugh", Stored: 0, Actual: 2}}},
+		{"stale row", map[string]int{"laugh": 2, "angry": 1}, nil, []biz.CounterDrift{
+			{SubjectId: 1, CommentId: 10, Field: "reaction_count:angry", Stored: 1, Actual: 0},
+			{SubjectId: 1, CommentId: 10, Field: "reaction_count:laugh", Stored: 2, Actual: 0},
+		}},
+	}
+	for _, tt := range tests {
+		got := driftValues(reactionCountDrifts(1, 10, tt.stored, tt.actual))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: drifts %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDriftedComments(t *testing.T) {
+	drifts := []*biz.CounterDrift{
+		{CommentId: 0, Field: "count"},
+		{CommentId: 10, Field: "count"},
+		{CommentId: 0, Field: "all_count"},
+		{CommentId: 10, Field: "like"},
+		{CommentId: 20, Field: "hate", Actual: 2},
+	}
+	if got, want := driftedComments(drifts), []uint64{0, 10, 20}; !reflect.DeepEqual(got, want) {
+		t.Errorf("drifted comments %v, want %v", got, want)
+	}
+	if got, want := driftFields(drifts[4:]), (orm.UpdateFields{"hate": 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("fields %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,98 @@
+package wordfilter
+
+import (
+	"bufio"
+	"github.com/go-kratos/kratos/v2/log"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Filter 从词库文件加载敏感词, 定时检查文件修改时间, 变更后重新构建自动机
+// 词库文件每行一个词, 空行与 # 开头的行会被忽略
+type Filter struct {
+	path string
+	modTime time.Time
+	matcher atomic.Value // *Matcher
+	stop chan struct{}
+	log *log.Helper
+}
+
+// NewFilter 加载词库文件并启动热加载, path 为空时不过滤任何内容
+func NewFilter(path string, interval time.Duration, logger log.Logger) (*Filter, func(), error) {
+	f := &Filter{
+		path: path,
+		stop: make(chan struct{}),
+		log: log.NewHelper(logger),
+	}
+	f.matcher.Store(NewMatcher(nil))
+	if path == "" {
+		return f, func() {}, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	f.modTime = info.ModTime()
+	if err = f.load(); err != nil {
+		return nil, nil, err
+	}
+	go f.watch(interval)
+	return f, func() {
+		close(f.stop)
+	}, nil
+}
+
+// Match 找出文本中所有的敏感词
+func (f *Filter) Match(text string) []Hit {
+	return f.matcher.Load().(*Matcher).Match(text)
+}
+
+func (f *Filter) load() error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		words = append(words, word)
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+	f.matcher.Store(NewMatcher(words))
+	f.log.Infof("loaded %d sensitive words from %s", len(words), f.path)
+	return nil
+}
+
+// watch 文件修改时间变化时重新加载, 加载失败时继续使用旧的词库直到文件再次修改
+func (f *Filter) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.stop:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(f.path)
+			if err != nil {
+				f.log.Errorf("stat sensitive word file %s err: %v", f.path, err)
+				continue
+			}
+			if info.ModTime().Equal(f.modTime) {
+				continue
+			}
+			f.modTime = info.ModTime()
+			if err = f.load(); err != nil {
+				f.log.Errorf("reload sensitive word file %s err: %v", f.path, err)
+			}
+		}
+	}
+}
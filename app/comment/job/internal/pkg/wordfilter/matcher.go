@@ -0,0 +1,130 @@
+package wordfilter
+
+import (
+	"unicode"
+)
+
+// Hit 一次敏感词命中, Start 与 End 为命中内容在文本中的字符下标, 左闭右开
+type Hit struct {
+	Word string `json:"word"`
+	Start int `json:"start"`
+	End int `json:"end"`
+}
+
+type node struct {
+	next map[rune]int
+	fail int
+	length int // 以该节点结尾的敏感词长度, 0 表示不是词尾
+	output int // 沿失配指针最近的词尾节点, 用于找出以同一位置结尾的所有敏感词
+}
+
+// Matcher 基于 Aho-Corasick 自动机的敏感词匹配, 一次扫描找出文本中所有的敏感词, 不区分大小写
+// 构建后只读, 可并发使用
+type Matcher struct {
+	nodes []node
+}
+
+// NewMatcher 由敏感词列表构建自动机
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{nodes: []node{{next: map[rune]int{}}}}
+	for _, word := range words {
+		m.insert(word)
+	}
+	m.build()
+	return m
+}
+
+func (m *Matcher) insert(word string) {
+	cur, length := 0, 0
+	for _, r := range word {
+		r = unicode.ToLower(r)
+		next, ok := m.nodes[cur].next[r]
+		if !ok {
+			m.nodes = append(m.nodes, node{next: map[rune]int{}})
+			next = len(m.nodes) - 1
+			m.nodes[cur].next[r] = next
+		}
+		cur = next
+		length++
+	}
+	if length > 0 {
+		m.nodes[cur].length = length
+	}
+}
+
+// build 按层序计算失配指针
+func (m *Matcher) build() {
+	var queue []int
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			fail := m.nodes[cur].fail
+			for fail > 0 {
+				if _, ok := m.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].next[r]; ok {
+				fail = next
+			}
+			m.nodes[child].fail = fail
+			if m.nodes[fail].length > 0 {
+				m.nodes[child].output = fail
+			} else {
+				m.nodes[child].output = m.nodes[fail].output
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Match 找出文本中所有的敏感词, 按结束位置排序, 重叠的命中都会返回
+func (m *Matcher) Match(text string) []Hit {
+	if len(m.nodes) == 1 {
+		return nil
+	}
+	var hits []Hit
+	runes := []rune(text)
+	cur := 0
+	for i, r := range runes {
+		r = unicode.ToLower(r)
+		for cur > 0 {
+			if _, ok := m.nodes[cur].next[r]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		if next, ok := m.nodes[cur].next[r]; ok {
+			cur = next
+		}
+		for out := cur; out > 0; out = m.nodes[out].output {
+			if length := m.nodes[out].length; length > 0 {
+				hits = append(hits, Hit{
+					Word: string(runes[i + 1 - length : i + 1]),
+					Start: i + 1 - length,
+					End: i + 1,
+				})
+			}
+		}
+	}
+	return hits
+}
+
+// Mask 将命中的敏感词替换为 *
+func Mask(text string, hits []Hit) string {
+	if len(hits) == 0 {
+		return text
+	}
+	runes := []rune(text)
+	for _, hit := range hits {
+		for i := hit.Start; i < hit.End && i < len(runes); i++ {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
@@ -0,0 +1,67 @@
+package wordfilter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcherMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		words []string
+		text string
+		want []Hit
+	}{
+		{"empty dictionary", nil, "anything", nil},
+		{"empty words only", []string{"", ""}, "anything", nil},
+		{"empty text", []string{"bad"}, "", nil},
+		{"no hit", []string{"bad"}, "good", nil},
+		{"single", []string{"bad"}, "a bad day", []Hit{{"bad", 2, 5}}},
+		{"repeated", []string{"ab"}, "abab", []Hit{{"ab", 0, 2}, {"ab", 2, 4}}},
+		{"overlapping", []string{"he", "she", "his", "hers"}, "ushers", []Hit{
+			{"she", 1, 4}, {"he", 2, 4}, {"hers", 2, 6},
+		}},
+		{"nested", []string{"abc", "b"}, "abc", []Hit{{"b", 1, 2}, {"abc", 0, 3}}},
+		{"self overlapping", []string{"aa"}, "aaa", []Hit{{"aa", 0, 2}, {"aa", 1, 3}}},
+		{"case insensitive", []string{"Bad"}, "BAD bad", []Hit{{"BAD", 0, 3}, {"bad", 4, 7}}},
+		{"unicode positions", []string{"敏感词"}, "这是敏感词汇", []Hit{{"敏感词", 2, 5}}},
+		{"mixed width", []string{"测试", "ab"}, "é测试ab😀ab", []Hit{{"测试", 1, 3}, {"ab", 3, 5}, {"ab", 6, 8}}},
+		{"fail transition", []string{"abcd", "bce"}, "abce", []Hit{{"bce", 1, 4}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewMatcher(tt.words).Match(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Match(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name string
+		words []string
+		text string
+		want string
+	}{
+		{"no hit", []string{"bad"}, "good", "good"},
+		{"ascii", []string{"bad"}, "a bad day", "a *** day"},
+		{"overlapping", []string{"he", "she", "hers"}, "ushers", "u*****"},
+		{"unicode", []string{"敏感"}, "这是敏感词", "这是**词"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits := NewMatcher(tt.words).Match(tt.text)
+			if got := Mask(tt.text, hits); got != tt.want {
+				t.Errorf("Mask(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaskOutOfRange(t *testing.T) {
+	if got := Mask("ab", []Hit{{"abc", 1, 5}}); got != "a*" {
+		t.Errorf("Mask = %q, want %q", got, "a*")
+	}
+}
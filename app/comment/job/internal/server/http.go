@@ -3,6 +3,7 @@ package server
 import (
 	"base-service/app/comment/job/internal/conf"
 	"base-service/app/comment/job/internal/service"
+	"expvar"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
@@ -33,6 +34,8 @@ func NewHTTPServer(c *conf.Server, _ *service.CommentJobService, logger log.Logg
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	// 计数校正等统计
+	srv.Handle("/debug/vars", expvar.Handler())
 	// v1.RegisterCommentJobServer(srv, greeter)
 	return srv
 }
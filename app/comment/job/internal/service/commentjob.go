@@ -6,97 +6,271 @@ import (
 	"base-service/app/comment/job/internal/data"
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 保存死信失败后重试的间隔, 每次翻倍直到上限
+const (
+	deadLetterRetryBackoff = time.Second
+	deadLetterRetryMaxBackoff = 30 * time.Second
 )
 
 type CommentJobService struct {
 	pb.UnimplementedCommentJobServer
 	uc *biz.CommentUsecase
+	nuc *biz.NotificationUsecase
+	duc *biz.DeadLetterUsecase
+	ruc *biz.ReconcileUsecase
 	log *log.Helper
-	kafka data.Queue
+	queue data.Queue
 }
 
-type SaveCommentMessage struct {
-	Subject biz.CommentSubject
-	Comment biz.Comment
+func NewCommentJobService(uc *biz.CommentUsecase, nuc *biz.NotificationUsecase, duc *biz.DeadLetterUsecase,
+	ruc *biz.ReconcileUsecase, logger log.Logger, d *data.Data) *CommentJobService {
+	service := &CommentJobService{
+		uc: uc,
+		nuc: nuc,
+		duc: duc,
+		ruc: ruc,
+		log: log.NewHelper(logger),
+		queue: d.Queue,
+	}
+	subscriptions := map[string]data.Handler{
+		"comment_chan": service.saveComment,
+		"comment-index-list-cache": service.buildCommentIndexCache,
+		"comment-score": service.updateCommentScore,
+		"comment-score-cache": service.buildCommentScoreCache,
+		data.CommentEventTopic: service.handleCommentEvent,
+	}
+	// 处理失败或 schema 版本未知的消息会进入死信主题, 保存后由管理员处理
+	for topic, handler := range subscriptions {
+		if err := service.queue.Subscribe(topic, handler); err != nil {
+			service.log.Errorf("subscribe kafka topic %s error: %v\n", topic, err)
+		}
+		if err := service.queue.Subscribe(topic + data.DeadLetterSuffix, service.saveDeadLetter); err != nil {
+			service.log.Errorf("subscribe kafka topic %s error: %v\n", topic + data.DeadLetterSuffix, err)
+		}
+	}
+	if err := service.queue.Start(); err != nil {
+		service.log.Errorf("start kafka consumer error: %v\n", err)
+	}
+	return service
 }
 
 
-type CommentIndexCacheMessage struct {
-	ObjId uint64
-	ObjType int
-	Page int
-	Size int
+// saveComment 保存评论, 保存失败时重试后进入死信主题
+func (s *CommentJobService) saveComment(ctx context.Context, msg *data.Message) error {
+	var param pb.SaveCommentMessage
+	if err := data.UnmarshalProto(msg, &param); err != nil {
+		s.log.Errorf("unmarshal message err: %v\n", err)
+		return err
+	}
+	s.log.Infow("type", "subscribe", "topic", msg.Topic, "value", param.String())
+	if err := s.uc.CreateComment(ctx, toBizSubject(param.Subject), toBizComment(param.Comment)); err != nil {
+		s.log.Errorf("save comment err: %v\n", err)
+		return err
+	}
+	return nil
 }
 
+// handleCommentEvent 评论事件, 刷新缓存与排序分数并生成通知, 事件可能重复投递
+func (s *CommentJobService) handleCommentEvent(ctx context.Context, msg *data.Message) error {
+	var param pb.CommentEvent
+	if err := data.UnmarshalProto(msg, &param); err != nil {
+		s.log.Errorf("unmarshal message err: %v\n", err)
+		return err
+	}
+	s.log.Infow("type", "subscribe", "topic", msg.Topic, "value", param.String())
+	switch event := param.Event.(type) {
+	case *pb.CommentEvent_Created:
+		return s.commentCreated(ctx, event.Created)
+	case *pb.CommentEvent_Liked:
+		return s.commentLiked(ctx, event.Liked)
+	case *pb.CommentEvent_Deleted:
+		return s.refreshComment(ctx, event.Deleted.CommentId)
+	case *pb.CommentEvent_Edited:
+		return s.commentEdited(ctx, event.Edited)
+	case *pb.CommentEvent_StateChanged:
+		return s.refreshComment(ctx, event.StateChanged.CommentId)
+	case *pb.CommentEvent_Pinned:
+		return s.refreshComment(ctx, event.Pinned.CommentId)
+	}
+	return data.Permanent(fmt.Errorf("unknown comment event %T", param.Event))
+}
 
-func NewCommentJobService(uc *biz.CommentUsecase, logger log.Logger, d *data.Data) *CommentJobService {
-	service := &CommentJobService{
-		uc: uc,
-		log: log.NewHelper(logger),
-		kafka: d.Kafka,
+func (s *CommentJobService) commentCreated(ctx context.Context, event *pb.CommentCreated) error {
+	if err := s.refreshComment(ctx, event.CommentId); err != nil {
+		return err
+	}
+	mentions := make([]biz.Mention, len(event.MentionMemberIds))
+	for i, memberId := range event.MentionMemberIds {
+		mentions[i] = biz.Mention{MemberId: memberId}
 	}
-/*	err := service.kafka.Subscribe("comment", func(ctx context.Context, message *data.Message) {
-		service.log.Infow("type", "subscribe", "topic", message.Topic, "value", string(message.Value))
+	err := s.nuc.NotifyComment(ctx, &biz.CommentSubject{
+		ObjId: event.ObjId,
+		ObjType: int(event.ObjType),
+	}, &biz.Comment{
+		Id: event.CommentId,
+		MemberId: event.MemberId,
+		Root: event.Root,
+		Parent: event.Parent,
+		ParentMemberId: event.ParentMemberId,
+		State: int8(event.State),
+		Mentions: mentions,
 	})
 	if err != nil {
-		service.log.Errorf("subscribe kafka error: %v\n", err)
-		return nil
-	}*/
-	go service.kafkaQueue()
-	go service.cacheBuildQueue()
-	return &CommentJobService{}
+		s.log.Errorf("notify comment %d err: %v\n", event.CommentId, err)
+		return err
+	}
+	return nil
 }
 
+func (s *CommentJobService) commentEdited(ctx context.Context, event *pb.CommentEdited) error {
+	if err := s.uc.FilterEditedComment(ctx, event.CommentId); err != nil {
+		s.log.Errorf("filter edited comment %d err: %v\n", event.CommentId, err)
+		return err
+	}
+	return s.refreshComment(ctx, event.CommentId)
+}
 
-func (s *CommentJobService) kafkaQueue() {
-	consumer, err := s.kafka.SubscribeChan("comment_chan", 256)
-	if err != nil {
-		s.log.Errorf("subscribe kafka chan error: %v\n", err)
-		return
-	}
-	for msg := range consumer.Receive() {
-		s.log.Infow("type", "subscribe channel", "topic", msg.Topic, "value", string(msg.Value))
-		// 处理消息
-		var param SaveCommentMessage
-		err = json.Unmarshal(msg.Value, &param)
-		if err != nil {
-			s.log.Errorf("unmarshal message err: %v\n", err)
-			continue
-		}
-		err = s.uc.CreateComment(context.Background(), &param.Subject, &param.Comment)
-		if err != nil {
-			s.log.Errorf("save comment err: %v\n", err)
-		}
+func (s *CommentJobService) commentLiked(ctx context.Context, event *pb.CommentLiked) error {
+	if err := s.refreshComment(ctx, event.CommentId); err != nil {
+		return err
 	}
+	if err := s.nuc.NotifyReaction(ctx, event.CommentId, event.MemberId, event.Reaction); err != nil {
+		s.log.Errorf("notify reaction err: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func (s *CommentJobService) refreshComment(ctx context.Context, id uint64) error {
+	if err := s.uc.RefreshComment(ctx, id); err != nil {
+		s.log.Errorf("refresh comment %d err: %v\n", id, err)
+		return err
+	}
+	return nil
 }
 
-// 构建缓存消息
-func (s *CommentJobService) cacheBuildQueue() {
-	consumer, err := s.kafka.SubscribeChan("comment-index-list-cache", 256)
+// buildCommentIndexCache 构建缓存消息, 缓存可由下次查询重建, 失败时不重试
+func (s *CommentJobService) buildCommentIndexCache(ctx context.Context, msg *data.Message) error {
+	var param pb.CommentIndexCacheMessage
+	if err := data.UnmarshalProto(msg, &param); err != nil {
+		s.log.Errorf("unmarshal message err: %v\n", err)
+		return err
+	}
+	s.log.Infow("type", "subscribe", "topic", msg.Topic, "value", param.String())
+	err := s.uc.BuildCommentIndexCache(ctx, biz.CommentIndexCache{
+		ObjId:   param.ObjId,
+		ObjType: int(param.ObjType),
+		Floor:   int(param.Floor),
+		Size:    int(param.Size),
+	})
 	if err != nil {
-		s.log.Errorf("subscribe kafka chan comment-index-list-cache error: %v\n", err)
-		return
-	}
-	for msg := range consumer.Receive() {
-		s.log.Infow("type", "subscribe channel", "topic", msg.Topic, "value", string(msg.Value))
-		// 处理消息
-		var param CommentIndexCacheMessage
-		err = json.Unmarshal(msg.Value, &param)
-		if err != nil {
-			s.log.Errorf("unmarshal message err: %v\n", err)
-			continue
+		s.log.Errorf("build comment cache err: %v\n", err)
+	}
+	return nil
+}
+
+// updateCommentScore 排序分数更新消息, 评论服务改为写入评论事件, 保留以处理升级前未消费的消息
+func (s *CommentJobService) updateCommentScore(ctx context.Context, msg *data.Message) error {
+	var param pb.CommentScoreMessage
+	if err := data.UnmarshalProto(msg, &param); err != nil {
+		s.log.Errorf("unmarshal message err: %v\n", err)
+		return err
+	}
+	if err := s.uc.UpdateCommentScore(ctx, param.CommentId); err != nil {
+		s.log.Errorf("update comment score err: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+// buildCommentScoreCache 构建排序缓存消息, 失败时不重试
+func (s *CommentJobService) buildCommentScoreCache(ctx context.Context, msg *data.Message) error {
+	var param pb.CommentScoreCacheMessage
+	if err := data.UnmarshalProto(msg, &param); err != nil {
+		s.log.Errorf("unmarshal message err: %v\n", err)
+		return err
+	}
+	s.log.Infow("type", "subscribe", "topic", msg.Topic, "value", param.String())
+	if err := s.uc.BuildCommentScoreCache(ctx, param.ObjId, int(param.ObjType)); err != nil {
+		s.log.Errorf("build comment score cache err: %v\n", err)
+	}
+	return nil
+}
+
+// saveDeadLetter 保存死信主题中的消息, 保存失败时持续重试直到成功或停止消费, 不会进入下一级死信主题
+// 停止消费时返回错误, 位移不会提交, 重启后重新保存
+func (s *CommentJobService) saveDeadLetter(ctx context.Context, msg *data.Message) error {
+	var param data.DeadLetterMessage
+	if err := json.Unmarshal(msg.Value, &param); err != nil {
+		s.log.Errorf("unmarshal dead letter err: %v\n", err)
+		return nil
+	}
+	deadLetter := &biz.DeadLetter{
+		Topic: param.Topic,
+		Partition: param.Partition,
+		Offset: param.Offset,
+		Key: param.Key,
+		Headers: param.Headers,
+		Value: param.Value,
+		Reason: param.Reason,
+		Attempts: param.Attempts,
+		FailedAt: param.FailedAt,
+	}
+	backoff := deadLetterRetryBackoff
+	for {
+		err := s.duc.SaveDeadLetter(ctx, deadLetter)
+		if err == nil {
+			return nil
+		}
+		s.log.Errorf("save dead letter %s/%d/%d err: %v, retry after %s\n", param.Topic, param.Partition, param.Offset, err, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
 		}
-		err = s.uc.BuildCommentIndexCache(context.Background(), biz.CommentIndexCache{
-			ObjId:   param.ObjId,
-			ObjType: param.ObjType,
-			Page:    param.Page,
-			Size:    param.Size,
-		})
-		if err != nil {
-			s.log.Errorf("build comment cache err: %v\n", err)
+		if backoff *= 2; backoff > deadLetterRetryMaxBackoff {
+			backoff = deadLetterRetryMaxBackoff
 		}
 	}
 }
 
+func toBizSubject(subject *pb.SaveCommentMessage_Subject) *biz.CommentSubject {
+	return &biz.CommentSubject{
+		ObjId:    subject.GetObjId(),
+		ObjType:  int(subject.GetObjType()),
+		MemberId: subject.GetMemberId(),
+	}
+}
 
+func toBizComment(comment *pb.SaveCommentMessage_Comment) *biz.Comment {
+	mentions := make([]biz.Mention, len(comment.GetMentions()))
+	for i, mention := range comment.GetMentions() {
+		mentions[i] = biz.Mention{
+			MemberId: mention.MemberId,
+			Start:    int(mention.Start),
+			End:      int(mention.End),
+		}
+	}
+	return &biz.Comment{
+		Id:             comment.GetId(),
+		MemberId:       comment.GetMemberId(),
+		Root:           comment.GetRoot(),
+		Parent:         comment.GetParent(),
+		ParentMemberId: comment.GetParentMemberId(),
+		State:          int8(comment.GetState()),
+		AtMemberIds:    comment.GetAtMemberIds(),
+		Ip:             comment.GetIp(),
+		Platform:       int8(comment.GetPlatform()),
+		Device:         comment.GetDevice(),
+		Message:        comment.GetMessage(),
+		Meta:           comment.GetMeta(),
+		Mentions:       mentions,
+		IdempotencyKey: comment.GetIdempotencyKey(),
+		IdempotencyFingerprint: comment.GetIdempotencyFingerprint(),
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	pb "base-service/api/comment/job/v1"
+	"base-service/app/comment/job/internal/biz"
+	"base-service/app/comment/job/internal/conf"
+	"base-service/app/comment/job/internal/data"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeDeadLetterRepo 前 failures 次保存失败
+type fakeDeadLetterRepo struct {
+	biz.DeadLetterRepo
+	failures int
+	attempts int
+	saved []*biz.DeadLetter
+}
+
+func (r *fakeDeadLetterRepo) SaveDeadLetter(_ context.Context, deadLetter *biz.DeadLetter) error {
+	r.attempts++
+	if r.attempts <= r.failures {
+		return errors.New("db unavailable")
+	}
+	r.saved = append(r.saved, deadLetter)
+	return nil
+}
+
+func TestSaveDeadLetter(t *testing.T) {
+	value, _ := json.Marshal(data.DeadLetterMessage{Topic: "comment_chan", Partition: 1, Offset: 2, Reason: "boom"})
+	tests := []struct {
+		name string
+		value []byte
+		failures int
+		timeout time.Duration
+		wantErr bool
+		wantSaved int
+	}{
+		{"saved", value, 0, time.Minute, false, 1},
+		{"retry until saved", value, 1, time.Minute, false, 1},
+		{"keep retrying until stopped", value, 1 << 30, 100 * time.Millisecond, true, 0},
+		{"malformed", []byte("{"), 0, time.Minute, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeDeadLetterRepo{failures: tt.failures}
+			s := &CommentJobService{
+				duc: biz.NewDeadLetterUsecase(repo, log.DefaultLogger),
+				log: log.NewHelper(log.DefaultLogger),
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+			err := s.saveDeadLetter(ctx, &data.Message{Topic: "comment_chan.dlq", Value: tt.value})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, want err %v", err, tt.wantErr)
+			}
+			if len(repo.saved) != tt.wantSaved {
+				t.Errorf("saved %d, want %d", len(repo.saved), tt.wantSaved)
+			}
+			if tt.wantSaved > 0 && repo.saved[0].Topic != "comment_chan" {
+				t.Errorf("saved topic = %s", repo.saved[0].Topic)
+			}
+		})
+	}
+}
+
+// fakeRefreshRepo 记录刷新缓存与排序分数的评论
+type fakeRefreshRepo struct {
+	biz.CommentRepo
+	cached []uint64
+	scored []uint64
+}
+
+func (r *fakeRefreshRepo) RefreshCommentIndexCache(_ context.Context, id uint64) error {
+	r.cached = append(r.cached, id)
+	return nil
+}
+
+func (r *fakeRefreshRepo) UpdateCommentScore(_ context.Context, id uint64) error {
+	r.scored = append(r.scored, id)
+	return nil
+}
+
+func TestHandleCommentEventRefresh(t *testing.T) {
+	tests := []struct {
+		name string
+		event *pb.CommentEvent
+	}{
+		{"deleted", &pb.CommentEvent{Event: &pb.CommentEvent_Deleted{Deleted: &pb.CommentDeleted{CommentId: 1}}}},
+		{"hidden", &pb.CommentEvent{Event: &pb.CommentEvent_StateChanged{StateChanged: &pb.CommentStateChanged{
+			CommentId: 1, OldState: int32(biz.CommentStatePublished), State: int32(biz.CommentStateHidden)}}}},
+		{"admin deleted", &pb.CommentEvent{Event: &pb.CommentEvent_StateChanged{StateChanged: &pb.CommentStateChanged{
+			CommentId: 1, OldState: int32(biz.CommentStatePublished), State: int32(biz.CommentStateAdminDeleted)}}}},
+		{"pinned", &pb.CommentEvent{Event: &pb.CommentEvent_Pinned{Pinned: &pb.CommentPinned{CommentId: 1, Pinned: true}}}},
+		{"unpinned", &pb.CommentEvent{Event: &pb.CommentEvent_Pinned{Pinned: &pb.CommentPinned{CommentId: 1}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRefreshRepo{}
+			s := &CommentJobService{
+				uc: biz.NewCommentUsecase(repo, nil, &conf.Data{}, log.DefaultLogger),
+				log: log.NewHelper(log.DefaultLogger),
+			}
+			value, _ := proto.Marshal(tt.event)
+			err := s.handleCommentEvent(context.Background(), &data.Message{
+				Topic: data.CommentEventTopic,
+				Value: value,
+				Headers: map[string]string{data.SchemaVersionHeader: strconv.Itoa(int(pb.SchemaVersion_SCHEMA_VERSION_V1))},
+			})
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if want := []uint64{1}; !reflect.DeepEqual(repo.cached, want) || !reflect.DeepEqual(repo.scored, want) {
+				t.Errorf("cached %v scored %v, want %v", repo.cached, repo.scored, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	pb "base-service/api/comment/job/v1"
+)
+
+func (s *CommentJobService) ListDeadLetter(ctx context.Context, req *pb.ListDeadLetterRequest) (*pb.ListDeadLetterReply, error) {
+	deadLetters, err := s.duc.ListDeadLetter(ctx, req.Topic, int8(req.State), int(req.Page), int(req.Size))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.DeadLetterData, len(deadLetters))
+	for i, item := range deadLetters {
+		result[i] = &pb.DeadLetterData{
+			Id:        item.Id,
+			Topic:     item.Topic,
+			Partition: item.Partition,
+			Offset:    item.Offset,
+			Value:     item.Value,
+			Reason:    item.Reason,
+			Attempts:  int32(item.Attempts),
+			State:     int32(item.State),
+			FailedAt:  item.FailedAt.Unix(),
+			CreatedAt: item.CreatedAt.Unix(),
+			UpdatedAt: item.UpdatedAt.Unix(),
+			Key:       item.Key,
+		}
+	}
+	return &pb.ListDeadLetterReply{DeadLetters: result}, nil
+}
+
+func (s *CommentJobService) ReplayDeadLetter(ctx context.Context, req *pb.ReplayDeadLetterRequest) (*pb.ReplayDeadLetterReply, error) {
+	count, err := s.duc.ReplayDeadLetter(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReplayDeadLetterReply{Count: int32(count)}, nil
+}
+
+func (s *CommentJobService) DiscardDeadLetter(ctx context.Context, req *pb.DiscardDeadLetterRequest) (*pb.DiscardDeadLetterReply, error) {
+	count, err := s.duc.DiscardDeadLetter(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DiscardDeadLetterReply{Count: int32(count)}, nil
+}
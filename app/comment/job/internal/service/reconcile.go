@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	pb "base-service/api/comment/job/v1"
+)
+
+func (s *CommentJobService) ReconcileCounter(ctx context.Context, req *pb.ReconcileCounterRequest) (*pb.ReconcileCounterReply, error) {
+	drifts, err := s.ruc.ReconcileCounter(ctx, req.ObjId, int(req.ObjType), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.CounterDrift, len(drifts))
+	for i, item := range drifts {
+		result[i] = &pb.CounterDrift{
+			SubjectId: item.SubjectId,
+			CommentId: item.CommentId,
+			Field:     item.Field,
+			Stored:    int64(item.Stored),
+			Actual:    int64(item.Actual),
+		}
+	}
+	return &pb.ReconcileCounterReply{Drifts: result}, nil
+}
@@ -70,7 +70,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := initApp(bc.Server, bc.Registry, bc.Data, logger)
+	app, cleanup, err := initApp(bc.Server, bc.Registry, bc.Data, c, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -12,20 +12,28 @@ import (
 	"base-service/app/comment/service/internal/server"
 	"base-service/app/comment/service/internal/service"
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 // Injectors from wire.go:
 
 // initApp init kratos application.
-func initApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func initApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, configConfig config.Config, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	commentRepo := data.NewCommentRepo(dataData, logger)
-	commentUsecase := biz.NewCommentUsecase(commentRepo, logger)
-	commentService := service.NewCommentService(commentUsecase, logger)
+	policyRegistry, err := biz.NewPolicyRegistry(confData, configConfig, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	commentUsecase := biz.NewCommentUsecase(commentRepo, policyRegistry, confData, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, logger)
+	commentService := service.NewCommentService(commentUsecase, notificationUsecase, logger)
 	httpServer := server.NewHTTPServer(confServer, commentService, logger)
 	grpcServer := server.NewGRPCServer(confServer, commentService, logger)
 	registrar := server.NewRegistrar(registry)
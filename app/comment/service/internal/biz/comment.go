@@ -1,11 +1,133 @@
 package biz
 
 import (
+	"base-service/app/comment/service/internal/conf"
+	"base-service/app/comment/service/internal/pkg/errs"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// 评论状态
+const (
+	CommentStatePublished int8 = iota // 已发布
+	CommentStatePending               // 待审核
+	CommentStateHidden                // 已隐藏
+	CommentStateRejected              // 审核未通过
+	CommentStateAdminDeleted          // 管理员删除
+)
+
+// 评论的保存状态, 发表后评论由 job 异步保存
+const (
+	CommentStatusQueued int8 = iota // 已发表, 等待 job 保存
+	CommentStatusPersisted          // 已保存
+	CommentStatusRejected           // 已保存, 审核未通过
+)
+
+// defaultQueuedTTL 未保存的评论对作者可见的默认时长
+const defaultQueuedTTL = 10 * time.Minute
+
+// defaultIdempotencyWindow 相同请求id的重复发表返回第一次发表的评论id的默认时长
+const defaultIdempotencyWindow = 24 * time.Hour
+
+// idempotencyPendingTTL 发表请求处理中时请求id的有效期, 处理中的实例退出后请求id在此之后可以重新使用
+const idempotencyPendingTTL = time.Minute
+
+// maxIdempotencyKeyLength 请求id的最大长度
+const maxIdempotencyKeyLength = 64
+
+// 主题描述信息的最大长度, 标题与链接按字符计算, 扩展属性按编码后的字节计算
+const (
+	maxSubjectTitleLength = 255
+	maxSubjectUrlLength = 1024
+	maxSubjectAttributesLength = 4096
+)
+
+// 评论主题状态
+const (
+	SubjectStateOpen int8 = iota // 开放
+	SubjectStateClosed           // 关闭, 不能发表新评论
+	SubjectStateLocked           // 锁定, 评论区只读
+)
+
+// 根评论排序方式
+const (
+	CommentSortFloor = iota // 按楼层倒序
+	CommentSortHot          // 按热度, 综合点赞数、回复数与发布时间
+	CommentSortTop          // 按点赞数
+)
+
+// 评论列表每页条数, 未指定时使用默认值, 超过上限时按上限返回
+const (
+	defaultPageSize = 20
+	maxPageSize = 100
+)
+
+// clampPageSize 将每页条数限制在 [1, maxPageSize]
+func clampPageSize(size int) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
+
+// IsValidCommentSort 是否为支持的排序方式
+func IsValidCommentSort(sort int) bool {
+	return sort >= CommentSortFloor && sort <= CommentSortTop
+}
+
+// like 与 dislike 两种表态同时计入评论的点赞与点踩数, 热度与点赞排序依赖点赞数
+const (
+	ReactionLike = "like"
+	ReactionDislike = "dislike"
+)
+
+// CommentVisibleStates 列表中可见的评论状态, 主题与根评论的计数只统计这些状态
+var CommentVisibleStates = []int8{CommentStatePublished}
+
+// CommentAuthorVisibleStates 作者查看自己的评论时可见的状态, 包含等待审核的评论
+var CommentAuthorVisibleStates = []int8{CommentStatePublished, CommentStatePending}
+
+// commentStateTransitions 评论状态允许的流转
+var commentStateTransitions = map[int8][]int8{
+	CommentStatePending:   {CommentStatePublished, CommentStateRejected, CommentStateAdminDeleted},
+	CommentStatePublished: {CommentStateHidden, CommentStateAdminDeleted},
+	CommentStateHidden:    {CommentStatePublished, CommentStateAdminDeleted},
+	CommentStateRejected:  {CommentStatePublished, CommentStateAdminDeleted},
+}
+
+// IsCommentVisible 评论是否对外可见
+func IsCommentVisible(state int8) bool {
+	for _, s := range CommentVisibleStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransitCommentState 评论能否从 from 流转到 to
+func CanTransitCommentState(from, to int8) bool {
+	for _, s := range commentStateTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
 // CommentSubject 评论主题对象
 type CommentSubject struct {
 	Id uint64
@@ -16,13 +138,48 @@ type CommentSubject struct {
 	RootCount int
 	AllCount int
 	State int8
+	StateChangedAt time.Time // 最近一次手动变更状态的时间, 零值表示从未变更
+	Title string
+	Url string // 主题的规范链接
+	Attributes map[string]string // 扩展属性
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// sameInfo 标题、链接与扩展属性是否相同
+func (s *CommentSubject) sameInfo(o *CommentSubject) bool {
+	if s.Title != o.Title || s.Url != o.Url || len(s.Attributes) != len(o.Attributes) {
+		return false
+	}
+	for k, v := range s.Attributes {
+		if ov, ok := o.Attributes[k]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// validateSubjectInfo 检查标题、链接与扩展属性的长度
+func validateSubjectInfo(subject *CommentSubject) error {
+	if utf8.RuneCountInString(subject.Title) > maxSubjectTitleLength ||
+		utf8.RuneCountInString(subject.Url) > maxSubjectUrlLength {
+		return errs.ErrInvalidSubject
+	}
+	if len(subject.Attributes) > 0 {
+		b, err := json.Marshal(subject.Attributes)
+		if err != nil || len(b) > maxSubjectAttributesLength {
+			return errs.ErrInvalidSubject
+		}
+	}
+	return nil
+}
+
 // Comment 评论本身
 type Comment struct {
 	Id uint64
+	SubjectId uint64
+	ObjId uint64
+	ObjType int
 	MemberId uint64
 	Root uint64
 	Parent uint64
@@ -31,7 +188,6 @@ type Comment struct {
 	Count int
 	RootCount int
 	Like int
-	Liked bool
 	Hate int
 	State int8
 	AtMemberIds string
@@ -40,88 +196,818 @@ type Comment struct {
 	Device string
 	Message string
 	Meta string
+	Edited bool
+	EditedAt time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Score float64 // 按热度或点赞排序时的排序分数
+	Pinned bool
+	Reactions map[string]int // 各表态类型的计数
+	FilterWords []string // 命中的敏感词
+	Mentions []Mention
+	Queued bool // 已发表但尚未保存, 只对作者可见
+	IdempotencyKey string // 客户端请求id, 用于识别重复的发表请求
+	IdempotencyFingerprint string // 请求内容的摘要, 与请求id一起交给 job 记录
 	Replies []*Comment
 }
 
-type LikeItem struct {
+// IdempotencyRecord 请求id对应的发表请求
+type IdempotencyRecord struct {
 	CommentId uint64
-	Like bool
+	Fingerprint string // 请求内容的摘要, 相同请求id的请求内容不同时拒绝
+	Done bool // 评论已发送给 job, 处理中的请求为 false
+}
+
+// Mention 评论中 @ 的成员, Start 与 End 为 @昵称 在 Message 中的字符下标, 左闭右开
+type Mention struct {
+	MemberId uint64 `json:"member_id"`
+	Start int `json:"start"`
+	End int `json:"end"`
+}
+
+// RateLimit 滑动窗口限流规则, Window 内 Key 最多通过 Count 次
+type RateLimit struct {
+	Key string
+	Count int
+	Window time.Duration
+}
+
+// CommentCursor 评论列表的翻页游标, 指向上一页最后一条评论
+// 楼层排序按 Floor, Id 定位; 热度与点赞排序按 Score, Id 定位
+type CommentCursor struct {
+	Sort int
+	Floor int
+	Id uint64
+	Score float64
+}
+
+// ParseCommentCursor 解析客户端传入的游标, 空字符串表示从第一页开始
+func ParseCommentCursor(s string) (*CommentCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 4 {
+		return nil, errs.ErrInvalidCursor
+	}
+	cursor := &CommentCursor{}
+	if cursor.Sort, err = strconv.Atoi(parts[0]); err != nil || !IsValidCommentSort(cursor.Sort) {
+		return nil, errs.ErrInvalidCursor
+	}
+	if cursor.Floor, err = strconv.Atoi(parts[1]); err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	if cursor.Id, err = strconv.ParseUint(parts[2], 10, 64); err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	if cursor.Score, err = strconv.ParseFloat(parts[3], 64); err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+// String 编码为对客户端不透明的字符串
+func (c *CommentCursor) String() string {
+	if c == nil {
+		return ""
+	}
+	raw := fmt.Sprintf("%d:%d:%d:%s", c.Sort, c.Floor, c.Id, strconv.FormatFloat(c.Score, 'g', -1, 64))
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// CommentPage 一页评论及翻页信息
+type CommentPage struct {
+	Comments []*Comment
+	NextCursor *CommentCursor
+	HasMore bool
+}
+
+// NewCommentPage 以本页最后一条评论作为下一页的游标
+func NewCommentPage(comments []*Comment, sort int, hasMore bool) *CommentPage {
+	page := &CommentPage{
+		Comments: comments,
+		HasMore: hasMore,
+	}
+	if hasMore && len(comments) > 0 {
+		last := comments[len(comments) - 1]
+		page.NextCursor = &CommentCursor{Sort: sort, Floor: last.Floor, Id: last.Id, Score: last.Score}
+	}
+	return page
 }
 
 type CommentRepo interface {
+	// CreateSubject 主题不存在时创建, 已存在且没有所有者时设置所有者, 并填充保存的主题
 	CreateSubject(ctx context.Context, subject *CommentSubject) error
+	// UpdateSubject 更新主题的所有者、标题、链接与扩展属性
+	UpdateSubject(ctx context.Context, subject *CommentSubject) error
 	ListCommentSubject(ctx context.Context, objIds []uint64, objType int) ([]*CommentSubject, error)
+	// GetSubjectByObj 查询评论主题, 不存在时返回 ErrNotFound
 	GetSubjectByObj(ctx context.Context, subject *CommentSubject) error
-	GetCommentList(ctx context.Context, subject *CommentSubject, page, size, replyCount int) ([]*Comment, error)
-	GetReplyList(ctx context.Context, rootId uint64, page, size int) ([] *Comment, error)
+	GetCommentList(ctx context.Context, subject *CommentSubject, sort int, cursor *CommentCursor, size, replyCount int) (*CommentPage, error)
+	GetReplyList(ctx context.Context, rootId uint64, cursor *CommentCursor, size int) (*CommentPage, error)
+	ListCommentsByMember(ctx context.Context, memberId uint64, cursor *CommentCursor, size int) (*CommentPage, error)
 	SaveComment(ctx context.Context, subject *CommentSubject, comment *Comment) error
 	GetCommentIndex(ctx context.Context, id uint64) (comment *Comment, err error)
-	UpdateLikeNum(ctx context.Context, comment *Comment) error
-	UpdateHateNum(ctx context.Context, comment *Comment) error
-	GetLikedComment(ctx context.Context, memberId uint64, commentIds []uint64) ([]*LikeItem, error)
+	UpdateReaction(ctx context.Context, id uint64, memberId uint64, reaction string) error
+	ListReactionCount(ctx context.Context, commentIds []uint64) (map[uint64]map[string]int, error)
+	ListMemberReaction(ctx context.Context, memberId uint64, commentIds []uint64) (map[uint64]string, error)
+	// DeleteComment 删除评论, 删除根评论时一并删除其回复并扣减计数
+	DeleteComment(ctx context.Context, id uint64) error
+	ListPendingComment(ctx context.Context, objType int, page, size int) ([]*Comment, error)
+	UpdateCommentState(ctx context.Context, comment *Comment, state int8) error
+	// UpdateCommentContent 修改评论内容并将状态由 comment.State 改为 state, 写入编辑事件
+	UpdateCommentContent(ctx context.Context, comment *Comment, state int8) error
+	GetSubjectById(ctx context.Context, id uint64) (*CommentSubject, error)
+	// UpdateCommentPinned 置顶或取消置顶评论, maxPinned 大于0时置顶数量达到上限返回 ErrPinLimitExceeded
+	UpdateCommentPinned(ctx context.Context, comment *Comment, pinned bool, maxPinned int) error
+	UpdateSubjectState(ctx context.Context, subject *CommentSubject, state int8) error
+	// TakeRateLimit 所有规则都未超限时计数一次并返回0, 否则不计数并返回需要等待的时间
+	TakeRateLimit(ctx context.Context, limits []*RateLimit) (time.Duration, error)
+	// SaveQueuedComment 记录已发表但尚未保存的评论, 过期后自动删除
+	SaveQueuedComment(ctx context.Context, comment *Comment, ttl time.Duration) error
+	// ListQueuedComment 查询成员在主题下尚未保存的评论, 已保存的不再返回
+	ListQueuedComment(ctx context.Context, memberId uint64, objId uint64, objType int) ([]*Comment, error)
+	// GetQueuedComment 查询已发表的评论, 不存在或已过期时返回 nil
+	GetQueuedComment(ctx context.Context, id uint64) (*Comment, error)
+	// ClaimIdempotencyKey 为成员的请求id分配评论id并记录为处理中, 请求id已被使用时返回已有的记录与 false
+	ClaimIdempotencyKey(ctx context.Context, memberId uint64, key string, fingerprint string, ttl time.Duration) (*IdempotencyRecord, bool, error)
+	// SaveIdempotencyKey 覆盖请求id的记录, 用于标记发表完成
+	SaveIdempotencyKey(ctx context.Context, memberId uint64, key string, record *IdempotencyRecord, ttl time.Duration) error
+	// GetSavedIdempotencyKey 查询 job 保存评论时记录的请求id, 不存在时返回 nil
+	GetSavedIdempotencyKey(ctx context.Context, memberId uint64, key string) (*IdempotencyRecord, error)
+	// ReleaseIdempotencyKey 发表失败时释放请求id, 使客户端可以用同一请求id重试
+	ReleaseIdempotencyKey(ctx context.Context, memberId uint64, key string) error
 }
 
 type CommentUsecase struct {
 	repo CommentRepo
+	policies *PolicyRegistry
+	editWindow time.Duration
+	queuedTTL time.Duration
+	idempotencyWindow time.Duration
+	maxPinned int
+	maxMentions int
+	reactionTypes map[string]bool
+	autoCloseAfter map[int]time.Duration
+	memberLimit RateLimit
+	ipLimit RateLimit
+	subjectLimit RateLimit
 	log *log.Helper
 }
 
-func NewCommentUsecase(repo CommentRepo, logger log.Logger) *CommentUsecase {
+func NewCommentUsecase(repo CommentRepo, policies *PolicyRegistry, c *conf.Data, logger log.Logger) *CommentUsecase {
+	reactionTypes := make(map[string]bool)
+	for _, reaction := range c.GetReaction().GetTypes() {
+		reactionTypes[reaction] = true
+	}
+	autoCloseAfter := make(map[int]time.Duration)
+	for objType, d := range c.GetSubject().GetAutoCloseAfter() {
+		autoCloseAfter[int(objType)] = d.AsDuration()
+	}
+	queuedTTL := c.GetComment().GetQueuedTtl().AsDuration()
+	if queuedTTL <= 0 {
+		queuedTTL = defaultQueuedTTL
+	}
+	idempotencyWindow := c.GetComment().GetIdempotencyWindow().AsDuration()
+	if idempotencyWindow <= 0 {
+		idempotencyWindow = defaultIdempotencyWindow
+	}
 	return &CommentUsecase{
 		repo: repo,
+		policies: policies,
+		reactionTypes: reactionTypes,
+		autoCloseAfter: autoCloseAfter,
+		memberLimit: newRateLimit(c.GetRateLimit().GetMember()),
+		ipLimit: newRateLimit(c.GetRateLimit().GetIp()),
+		subjectLimit: newRateLimit(c.GetRateLimit().GetSubject()),
+		editWindow: c.GetComment().GetEditWindow().AsDuration(),
+		queuedTTL: queuedTTL,
+		idempotencyWindow: idempotencyWindow,
+		maxPinned: int(c.GetComment().GetMaxPinned()),
+		maxMentions: int(c.GetComment().GetMaxMentions()),
 		log: log.NewHelper(logger),
 	}
 }
 
-func (uc *CommentUsecase) CreateSubject(ctx context.Context, subject *CommentSubject) error {
-	return uc.repo.CreateSubject(ctx, subject)
+func newRateLimit(c *conf.Data_RateLimit_Limit) RateLimit {
+	return RateLimit{
+		Count: int(c.GetCount()),
+		Window: c.GetWindow().AsDuration(),
+	}
+}
+
+// RegisterSubject 登记主题的所有者与描述信息, 主题已有其他所有者时返回 ErrPermissionDenied
+// 已存在的主题以本次登记的标题、链接与扩展属性为准
+func (uc *CommentUsecase) RegisterSubject(ctx context.Context, subject *CommentSubject) error {
+	if err := validateSubjectInfo(subject); err != nil {
+		return err
+	}
+	info := *subject
+	if err := uc.repo.CreateSubject(ctx, subject); err != nil {
+		return err
+	}
+	if subject.MemberId != info.MemberId {
+		return errs.ErrPermissionDenied
+	}
+	if subject.sameInfo(&info) {
+		return nil
+	}
+	subject.Title, subject.Url, subject.Attributes = info.Title, info.Url, info.Attributes
+	return uc.repo.UpdateSubject(ctx, subject)
+}
+
+// UpdateSubject 更新主题的标题、链接与扩展属性, 非管理员只允许主题所有者操作
+// ownerId 不为0时转移主题所有者, 只允许管理员操作
+func (uc *CommentUsecase) UpdateSubject(ctx context.Context, subject *CommentSubject, memberId uint64, admin bool, ownerId uint64) error {
+	if err := validateSubjectInfo(subject); err != nil {
+		return err
+	}
+	saved := &CommentSubject{ObjId: subject.ObjId, ObjType: subject.ObjType}
+	if err := uc.repo.GetSubjectByObj(ctx, saved); err != nil {
+		return err
+	}
+	if !admin && (memberId == 0 || saved.MemberId != memberId || ownerId != 0) {
+		return errs.ErrPermissionDenied
+	}
+	if ownerId != 0 {
+		saved.MemberId = ownerId
+	}
+	saved.Title, saved.Url, saved.Attributes = subject.Title, subject.Url, subject.Attributes
+	if err := uc.repo.UpdateSubject(ctx, saved); err != nil {
+		return err
+	}
+	*subject = *saved
+	return nil
 }
 
-// CreateComment 创建一条评论
+// CreateComment 创建一条评论, 先审后发的 obj_type 进入待审核状态, 关闭或锁定的主题不接受新评论
+// 带请求id的重复请求直接返回第一次发表的评论id, 不再重复发表
+// 第一次请求仍在处理时返回 ErrRequestInProgress, 请求id已被内容不同的请求使用时返回 ErrIdempotencyKeyReused
 func (uc *CommentUsecase) CreateComment(ctx context.Context, subject *CommentSubject, comment *Comment) error {
-	return uc.repo.SaveComment(ctx, subject, comment)
+	if comment.IdempotencyKey == "" {
+		return uc.createComment(ctx, subject, comment)
+	}
+	if len(comment.IdempotencyKey) > maxIdempotencyKeyLength {
+		return errs.ErrInvalidIdempotencyKey
+	}
+	comment.IdempotencyFingerprint = commentFingerprint(subject, comment)
+	record, claimed, err := uc.repo.ClaimIdempotencyKey(ctx, comment.MemberId, comment.IdempotencyKey,
+		comment.IdempotencyFingerprint, idempotencyPendingTTL)
+	if err != nil {
+		return err
+	}
+	if claimed {
+		// 去重窗口过期后, job 保存评论时记录的请求id仍然有效
+		saved, err := uc.repo.GetSavedIdempotencyKey(ctx, comment.MemberId, comment.IdempotencyKey)
+		if err != nil {
+			uc.releaseIdempotencyKey(ctx, comment)
+			return err
+		}
+		if saved != nil {
+			record, claimed = saved, false
+			if err = uc.repo.SaveIdempotencyKey(ctx, comment.MemberId, comment.IdempotencyKey, saved, uc.idempotencyWindow); err != nil {
+				uc.log.Errorf("save idempotency key %s err: %v", comment.IdempotencyKey, err)
+			}
+		}
+	}
+	if !claimed {
+		return checkIdempotencyRecord(record, comment)
+	}
+	comment.Id = record.CommentId
+	if err = uc.createComment(ctx, subject, comment); err != nil {
+		uc.releaseIdempotencyKey(ctx, comment)
+		return err
+	}
+	record.Done = true
+	if err = uc.repo.SaveIdempotencyKey(ctx, comment.MemberId, comment.IdempotencyKey, record, uc.idempotencyWindow); err != nil {
+		// 处理中的记录过期后, 重复的请求按 job 记录的请求id识别
+		uc.log.Errorf("save idempotency key %s err: %v", comment.IdempotencyKey, err)
+	}
+	return nil
+}
+
+// checkIdempotencyRecord 重复的请求内容一致且第一次请求已完成时返回第一次发表的评论id
+// 早于摘要记录的 job 记录没有摘要, 不检查内容
+func checkIdempotencyRecord(record *IdempotencyRecord, comment *Comment) error {
+	if record.Fingerprint != "" && record.Fingerprint != comment.IdempotencyFingerprint {
+		return errs.ErrIdempotencyKeyReused
+	}
+	if !record.Done {
+		return errs.ErrRequestInProgress
+	}
+	comment.Id = record.CommentId
+	return nil
+}
+
+// releaseIdempotencyKey 发表失败时释放请求id, 使客户端可以用同一请求id重试
+func (uc *CommentUsecase) releaseIdempotencyKey(ctx context.Context, comment *Comment) {
+	if err := uc.repo.ReleaseIdempotencyKey(ctx, comment.MemberId, comment.IdempotencyKey); err != nil {
+		uc.log.Errorf("release idempotency key %s err: %v", comment.IdempotencyKey, err)
+	}
+}
+
+// commentFingerprint 发表请求内容的摘要, 包括主题、回复对象与评论内容
+func commentFingerprint(subject *CommentSubject, comment *Comment) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n%d\n%d\n%d\n%s\n%s", subject.ObjId, subject.ObjType, comment.Root, comment.Parent,
+		comment.Message, comment.Meta)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (uc *CommentUsecase) createComment(ctx context.Context, subject *CommentSubject, comment *Comment) error {
+	policy := uc.policies.Get(subject.ObjType)
+	if err := checkMessageLength(policy, comment.Message); err != nil {
+		return err
+	}
+	if !policy.AllowDepth(replyDepth(comment)) {
+		return errs.ErrReplyNotAllowed
+	}
+	// 在创建主题之前限流, 被限流的请求不会创建主题
+	if err := uc.takeRateLimit(ctx, subject, comment); err != nil {
+		return err
+	}
+	saved := &CommentSubject{ObjId: subject.ObjId, ObjType: subject.ObjType, MemberId: subject.MemberId}
+	if err := uc.GetSubject(ctx, saved); err != nil {
+		if !errs.IsNotFound(err) {
+			return err
+		}
+		// 未登记的主题在第一条评论时创建
+		if err = uc.repo.CreateSubject(ctx, saved); err != nil {
+			return err
+		}
+		uc.applyAutoClose(saved)
+	}
+	if saved.State != SubjectStateOpen {
+		return errs.ErrSubjectClosed
+	}
+	uc.normalizeMentions(comment)
+	comment.State = CommentStatePublished
+	if policy.Moderation == ModerationPre {
+		comment.State = CommentStatePending
+	}
+	if err := uc.repo.SaveComment(ctx, subject, comment); err != nil {
+		return err
+	}
+	// job 保存前作者查询时合并返回, 记录失败不影响发表
+	comment.ObjId = subject.ObjId
+	comment.ObjType = subject.ObjType
+	comment.CreatedAt = time.Now()
+	comment.UpdatedAt = comment.CreatedAt
+	if err := uc.repo.SaveQueuedComment(ctx, comment, uc.queuedTTL); err != nil {
+		uc.log.Errorf("save queued comment %d err: %v", comment.Id, err)
+	}
+	return nil
 }
 
+// replyDepth 评论所在的层级, 根评论为1, 回复根评论为2, 回复楼中楼为3
+func replyDepth(comment *Comment) int {
+	switch {
+	case comment.Root == 0:
+		return 1
+	case comment.Parent == 0 || comment.Parent == comment.Root:
+		return 2
+	}
+	return 3
+}
+
+func checkMessageLength(policy *CommentPolicy, message string) error {
+	if policy.MaxMessageLength > 0 && utf8.RuneCountInString(message) > policy.MaxMessageLength {
+		return errs.ErrMessageTooLong
+	}
+	return nil
+}
+
+// GetPolicy 查询 obj_type 的评论策略
+func (uc *CommentUsecase) GetPolicy(objType int) *CommentPolicy {
+	return uc.policies.Get(objType)
+}
+
+// takeRateLimit 按成员、ip 与主题限制发表评论的频率
+func (uc *CommentUsecase) takeRateLimit(ctx context.Context, subject *CommentSubject, comment *Comment) error {
+	var limits []*RateLimit
+	add := func(limit RateLimit, key string) {
+		if limit.Count > 0 && limit.Window > 0 {
+			limit.Key = key
+			limits = append(limits, &limit)
+		}
+	}
+	if comment.MemberId != 0 {
+		add(uc.memberLimit, fmt.Sprintf("member:%d", comment.MemberId))
+	}
+	if comment.Ip != "" {
+		add(uc.ipLimit, fmt.Sprintf("ip:%s", comment.Ip))
+	}
+	add(uc.subjectLimit, fmt.Sprintf("subject:%d:%d", subject.ObjId, subject.ObjType))
+	if len(limits) == 0 {
+		return nil
+	}
+	retryAfter, err := uc.repo.TakeRateLimit(ctx, limits)
+	if err != nil {
+		// 限流不可用时放行, 不影响正常发表评论
+		uc.log.Errorf("take rate limit err: %v", err)
+		return nil
+	}
+	if retryAfter > 0 {
+		return errs.RateLimitError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// normalizeMentions 校验评论中的 @, 丢弃越界、重叠或不以 @ 开头的位置, 超出上限的部分忽略
+// AtMemberIds 由 @ 的成员生成, 以逗号分隔且不重复
+func (uc *CommentUsecase) normalizeMentions(comment *Comment) {
+	sort.Slice(comment.Mentions, func(i, j int) bool {
+		return comment.Mentions[i].Start < comment.Mentions[j].Start
+	})
+	runes := []rune(comment.Message)
+	var mentions []Mention
+	var memberIds []string
+	seen := make(map[uint64]bool)
+	end := 0
+	for _, mention := range comment.Mentions {
+		if uc.maxMentions > 0 && len(mentions) >= uc.maxMentions {
+			break
+		}
+		if mention.MemberId == 0 || mention.Start < end || mention.End <= mention.Start + 1 ||
+			mention.End > len(runes) || runes[mention.Start] != '@' {
+			continue
+		}
+		mentions = append(mentions, mention)
+		end = mention.End
+		if !seen[mention.MemberId] {
+			seen[mention.MemberId] = true
+			memberIds = append(memberIds, strconv.FormatUint(mention.MemberId, 10))
+		}
+	}
+	comment.Mentions = mentions
+	comment.AtMemberIds = strings.Join(memberIds, ",")
+}
+
+// GetSubject 查询评论主题, 返回的状态已考虑自动关闭, 主题不存在时返回 ErrNotFound
 func (uc *CommentUsecase) GetSubject(ctx context.Context, subject *CommentSubject) error {
-	return uc.repo.GetSubjectByObj(ctx, subject)
+	if err := uc.repo.GetSubjectByObj(ctx, subject); err != nil {
+		return err
+	}
+	uc.applyAutoClose(subject)
+	return nil
 }
 
 func (uc *CommentUsecase) ListCommentSubject(ctx context.Context, objIds []uint64, objType int) ([]*CommentSubject, error) {
-	return uc.repo.ListCommentSubject(ctx, objIds, objType)
+	subjects, err := uc.repo.ListCommentSubject(ctx, objIds, objType)
+	if err != nil {
+		return nil, err
+	}
+	for _, subject := range subjects {
+		uc.applyAutoClose(subject)
+	}
+	return subjects, nil
 }
 
-// GetComments 查询某个主题下的评论
-// @param replyCount int 子评论条数
-func (uc *CommentUsecase) GetComments(ctx context.Context, subject *CommentSubject, page int, size int, replyCount int) (comments []*Comment, err error) {
-	// 查询相关评论
-	comments, err = uc.repo.GetCommentList(ctx, subject, page, size, replyCount)
-	return
+// UpdateSubjectState 开放、关闭或锁定主题, 非管理员只允许主题所有者操作, 锁定与解除锁定只允许管理员操作
+// 手动变更过状态的主题不再自动关闭
+func (uc *CommentUsecase) UpdateSubjectState(ctx context.Context, subject *CommentSubject, memberId uint64, admin bool, state int8) error {
+	if state < SubjectStateOpen || state > SubjectStateLocked {
+		return errs.ErrInvalidState
+	}
+	err := uc.repo.GetSubjectByObj(ctx, subject)
+	// 管理员可以预先关闭或锁定未登记的主题, 成员不是未登记主题的所有者
+	if errs.IsNotFound(err) {
+		if !admin {
+			return errs.ErrPermissionDenied
+		}
+		err = uc.repo.CreateSubject(ctx, subject)
+	}
+	if err != nil {
+		return err
+	}
+	if !admin {
+		if memberId == 0 || subject.MemberId != memberId {
+			return errs.ErrPermissionDenied
+		}
+		if state == SubjectStateLocked || subject.State == SubjectStateLocked {
+			return errs.ErrPermissionDenied
+		}
+	}
+	return uc.repo.UpdateSubjectState(ctx, subject, state)
+}
+
+// applyAutoClose 开放中且从未手动变更状态的主题, 超过 obj_type 配置的时长后视为关闭
+func (uc *CommentUsecase) applyAutoClose(subject *CommentSubject) {
+	if subject.State != SubjectStateOpen || !subject.StateChangedAt.IsZero() {
+		return
+	}
+	after := uc.autoCloseAfter[subject.ObjType]
+	if after > 0 && time.Since(subject.CreatedAt) > after {
+		subject.State = SubjectStateClosed
+	}
+}
+
+// checkSubjectUnlocked 锁定的主题只读, 成员不能再修改其中的评论
+func (uc *CommentUsecase) checkSubjectUnlocked(ctx context.Context, subjectId uint64) error {
+	subject, err := uc.repo.GetSubjectById(ctx, subjectId)
+	if err != nil {
+		return err
+	}
+	if subject.State == SubjectStateLocked {
+		return errs.ErrSubjectClosed
+	}
+	return nil
+}
+
+// GetComments 查询某个主题下的评论, 翻页时沿用游标中的排序方式
+// @param sort int 排序方式, 不合法时使用 obj_type 的默认排序
+// @param replyCount int 子评论条数, 小于0时使用 obj_type 的默认值
+// @param memberId uint64 查看者id, 第一页合并该成员尚未保存的评论
+func (uc *CommentUsecase) GetComments(ctx context.Context, subject *CommentSubject, sort int, cursor *CommentCursor, size int, replyCount int, memberId uint64) (*CommentPage, error) {
+	policy := uc.policies.Get(subject.ObjType)
+	if cursor != nil {
+		sort = cursor.Sort
+	}
+	if !IsValidCommentSort(sort) {
+		sort = policy.DefaultSort
+	}
+	if replyCount < 0 {
+		replyCount = policy.ReplyPreviewCount
+	}
+	size = clampPageSize(size)
+	page, err := uc.repo.GetCommentList(ctx, subject, sort, cursor, size, replyCount)
+	if err != nil {
+		return nil, err
+	}
+	uc.fillReactionCount(ctx, page.Comments)
+	if memberId != 0 && cursor == nil {
+		uc.mergeQueuedComment(ctx, subject, memberId, page)
+	}
+	return page, nil
+}
+
+// mergeQueuedComment 尚未保存的根评论排在置顶评论之后, 回复追加到本页对应的根评论下
+func (uc *CommentUsecase) mergeQueuedComment(ctx context.Context, subject *CommentSubject, memberId uint64, page *CommentPage) {
+	queued, err := uc.repo.ListQueuedComment(ctx, memberId, subject.ObjId, subject.ObjType)
+	if err != nil {
+		uc.log.Errorf("list queued comment err: %v", err)
+		return
+	}
+	sort.Slice(queued, func(i, j int) bool {
+		return queued[i].Id > queued[j].Id
+	})
+	roots := make(map[uint64]*Comment, len(page.Comments))
+	pinned := 0
+	for _, comment := range page.Comments {
+		roots[comment.Id] = comment
+		if comment.Pinned {
+			pinned++
+		}
+	}
+	var queuedRoots []*Comment
+	for _, comment := range queued {
+		comment.Queued = true
+		if comment.Root == 0 {
+			queuedRoots = append(queuedRoots, comment)
+		} else if root, ok := roots[comment.Root]; ok {
+			root.Replies = append(root.Replies, comment)
+		}
+	}
+	if len(queuedRoots) == 0 {
+		return
+	}
+	comments := make([]*Comment, 0, len(page.Comments) + len(queuedRoots))
+	comments = append(comments, page.Comments[:pinned]...)
+	comments = append(comments, queuedRoots...)
+	page.Comments = append(comments, page.Comments[pinned:]...)
 }
 
-func (uc *CommentUsecase) GetCommentById(ctx context.Context, id uint64) (*Comment, error) {
-	return uc.repo.GetCommentIndex(ctx, id)
+// GetCommentById 查询一条评论, 评论尚未保存时只返回给作者
+func (uc *CommentUsecase) GetCommentById(ctx context.Context, id uint64, memberId uint64) (*Comment, error) {
+	comment, err := uc.repo.GetCommentIndex(ctx, id)
+	if errs.IsNotFound(err) && memberId != 0 {
+		queued, qErr := uc.repo.GetQueuedComment(ctx, id)
+		if qErr != nil {
+			return nil, qErr
+		}
+		if queued != nil && queued.MemberId == memberId {
+			queued.Queued = true
+			return queued, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	uc.fillReactionCount(ctx, []*Comment{comment})
+	return comment, nil
+}
+
+// GetCommentStatus 查询 memberId 发表的评论的保存状态, 既未保存也不在等待保存的评论以及其他成员的评论返回 ErrNotFound
+// job 丢弃或转入死信的评论不会记录失败状态, 在 queuedTTL 内仍为等待保存, 过期后返回 ErrNotFound
+func (uc *CommentUsecase) GetCommentStatus(ctx context.Context, id uint64, memberId uint64) (int8, *Comment, error) {
+	comment, err := uc.repo.GetCommentIndex(ctx, id)
+	if err == nil {
+		if comment.MemberId != memberId {
+			return 0, nil, errs.ErrNotFound
+		}
+		if comment.State == CommentStateRejected {
+			return CommentStatusRejected, comment, nil
+		}
+		return CommentStatusPersisted, comment, nil
+	}
+	if !errs.IsNotFound(err) {
+		return 0, nil, err
+	}
+	queued, err := uc.repo.GetQueuedComment(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if queued == nil || queued.MemberId != memberId {
+		return 0, nil, errs.ErrNotFound
+	}
+	return CommentStatusQueued, queued, nil
 }
 
 
 // GetReplies 查询一条评论下的回复
-func (uc *CommentUsecase) GetReplies(ctx context.Context, rootId uint64, page int, size int) (comments []*Comment, err error) {
-	comments, err = uc.repo.GetReplyList(ctx, rootId, page, size)
-	return
+func (uc *CommentUsecase) GetReplies(ctx context.Context, rootId uint64, cursor *CommentCursor, size int) (*CommentPage, error) {
+	if cursor != nil && cursor.Sort != CommentSortFloor {
+		return nil, errs.ErrInvalidCursor
+	}
+	page, err := uc.repo.GetReplyList(ctx, rootId, cursor, clampPageSize(size))
+	if err != nil {
+		return nil, err
+	}
+	uc.fillReactionCount(ctx, page.Comments)
+	return page, nil
+}
+
+// ListCommentsByMember 查询成员发表的评论, 按id倒序即发表时间倒序
+func (uc *CommentUsecase) ListCommentsByMember(ctx context.Context, memberId uint64, cursor *CommentCursor, size int) (*CommentPage, error) {
+	if cursor != nil && cursor.Sort != CommentSortFloor {
+		return nil, errs.ErrInvalidCursor
+	}
+	page, err := uc.repo.ListCommentsByMember(ctx, memberId, cursor, clampPageSize(size))
+	if err != nil {
+		return nil, err
+	}
+	uc.fillReactionCount(ctx, page.Comments)
+	return page, nil
+}
+
+// ReactComment 对评论表态, 每个成员对一条评论只保留一个表态, 新的表态会替换旧的, reaction 为空时取消表态
+func (uc *CommentUsecase) ReactComment(ctx context.Context, id uint64, memberId uint64, reaction string) error {
+	if reaction != "" && !uc.reactionTypes[reaction] {
+		return errs.ErrInvalidReaction
+	}
+	comment, err := uc.repo.GetCommentIndex(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !IsCommentVisible(comment.State) {
+		return errs.ErrInvalidState
+	}
+	if err := uc.checkSubjectUnlocked(ctx, comment.SubjectId); err != nil {
+		return err
+	}
+	return uc.repo.UpdateReaction(ctx, id, memberId, reaction)
+}
+
+// ListMemberReaction 批量查询成员对评论的表态
+func (uc *CommentUsecase) ListMemberReaction(ctx context.Context, memberId uint64, commentIds []uint64) (map[uint64]string, error) {
+	return uc.repo.ListMemberReaction(ctx, memberId, commentIds)
+}
+
+// fillReactionCount 填充评论及其回复的表态计数, 查询失败时不影响评论列表
+func (uc *CommentUsecase) fillReactionCount(ctx context.Context, comments []*Comment) {
+	var ids []uint64
+	for _, comment := range comments {
+		ids = append(ids, comment.Id)
+		for _, reply := range comment.Replies {
+			ids = append(ids, reply.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	counts, err := uc.repo.ListReactionCount(ctx, ids)
+	if err != nil {
+		uc.log.Errorf("list reaction count err: %v", err)
+		return
+	}
+	for _, comment := range comments {
+		comment.Reactions = counts[comment.Id]
+		for _, reply := range comment.Replies {
+			reply.Reactions = counts[reply.Id]
+		}
+	}
 }
 
-// LikeComment 评论点赞
-func (uc *CommentUsecase) LikeComment(ctx context.Context, id uint64, like int, memberId uint64) error {
-	comment := &Comment{
-		Id: id,
-		Like: like,
-		MemberId: memberId,
+// DeleteComment 删除评论, 非管理员只允许评论作者删除, 删除根评论时一并删除其回复
+func (uc *CommentUsecase) DeleteComment(ctx context.Context, id uint64, memberId uint64, admin bool) error {
+	comment, err := uc.repo.GetCommentIndex(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !admin {
+		if memberId == 0 || comment.MemberId != memberId {
+			return errs.ErrPermissionDenied
+		}
+		if err := uc.checkSubjectUnlocked(ctx, comment.SubjectId); err != nil {
+			return err
+		}
 	}
-	return uc.repo.UpdateLikeNum(ctx, comment)
+	return uc.repo.DeleteComment(ctx, id)
 }
 
+// UpdateComment 作者在编辑时限内修改评论内容, 修改前的内容保存为修订记录
+func (uc *CommentUsecase) UpdateComment(ctx context.Context, comment *Comment) error {
+	saved, err := uc.repo.GetCommentIndex(ctx, comment.Id)
+	if err != nil {
+		return err
+	}
+	if saved.MemberId != comment.MemberId {
+		return errs.ErrPermissionDenied
+	}
+	if saved.State == CommentStateRejected || saved.State == CommentStateAdminDeleted {
+		return errs.ErrInvalidState
+	}
+	if uc.editWindow > 0 && time.Since(saved.CreatedAt) > uc.editWindow {
+		return errs.ErrEditWindowExpired
+	}
+	if err := checkMessageLength(uc.policies.Get(saved.ObjType), comment.Message); err != nil {
+		return err
+	}
+	if err := uc.checkSubjectUnlocked(ctx, saved.SubjectId); err != nil {
+		return err
+	}
+	uc.normalizeMentions(comment)
+	// 先审后发的评论修改后重新进入待审核, 敏感词由 job 消费编辑事件后重新过滤
+	comment.State = saved.State
+	state := saved.State
+	if uc.policies.Get(saved.ObjType).Moderation == ModerationPre && saved.State == CommentStatePublished {
+		state = CommentStatePending
+	}
+	return uc.repo.UpdateCommentContent(ctx, comment, state)
+}
 
-func (uc *CommentUsecase) GetLikedComment(ctx context.Context, memberId uint64, commentIds []uint64) ([]*LikeItem, error) {
-	return uc.repo.GetLikedComment(ctx, memberId, commentIds)
-}
\ No newline at end of file
+// PinComment 置顶或取消置顶根评论, 非管理员只允许主题所有者操作
+func (uc *CommentUsecase) PinComment(ctx context.Context, id uint64, memberId uint64, admin bool, pinned bool) error {
+	comment, err := uc.repo.GetCommentIndex(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !admin {
+		if memberId == 0 {
+			return errs.ErrPermissionDenied
+		}
+		subject, err := uc.repo.GetSubjectById(ctx, comment.SubjectId)
+		if err != nil {
+			return err
+		}
+		if subject.MemberId != memberId {
+			return errs.ErrPermissionDenied
+		}
+		if subject.State == SubjectStateLocked {
+			return errs.ErrSubjectClosed
+		}
+	}
+	if comment.Pinned == pinned {
+		return nil
+	}
+	if pinned {
+		if comment.Root != 0 || !IsCommentVisible(comment.State) {
+			return errs.ErrInvalidState
+		}
+	}
+	return uc.repo.UpdateCommentPinned(ctx, comment, pinned, uc.maxPinned)
+}
+
+// ListPendingComment 查询待审核的评论, objType 为0时不区分类型
+func (uc *CommentUsecase) ListPendingComment(ctx context.Context, objType int, page, size int) ([]*Comment, error) {
+	return uc.repo.ListPendingComment(ctx, objType, page, size)
+}
+
+// ReviewComment 批量流转评论状态, 返回流转成功与失败的评论id
+func (uc *CommentUsecase) ReviewComment(ctx context.Context, ids []uint64, state int8) (succeeded []uint64, failed []uint64) {
+	for _, id := range ids {
+		if err := uc.TransitCommentState(ctx, id, state); err != nil {
+			uc.log.Errorf("review comment %d to state %d failed: %v", id, state, err)
+			failed = append(failed, id)
+			continue
+		}
+		succeeded = append(succeeded, id)
+	}
+	return
+}
+
+// TransitCommentState 按状态机流转单条评论的状态
+func (uc *CommentUsecase) TransitCommentState(ctx context.Context, id uint64, state int8) error {
+	comment, err := uc.repo.GetCommentIndex(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !CanTransitCommentState(comment.State, state) {
+		return errs.ErrInvalidState
+	}
+	return uc.repo.UpdateCommentState(ctx, comment, state)
+}
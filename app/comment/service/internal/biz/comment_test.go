@@ -0,0 +1,796 @@
+package biz
+
+import (
+	"base-service/app/comment/service/internal/conf"
+	"base-service/app/comment/service/internal/pkg/errs"
+	"context"
+	"encoding/base64"
+	"github.com/go-kratos/kratos/v2/log"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCanTransitCommentState(t *testing.T) {
+	tests := []struct {
+		from, to int8
+		want bool
+	}{
+		{CommentStatePending, CommentStatePublished, true},
+		{CommentStatePending, CommentStateRejected, true},
+		{CommentStatePending, CommentStateHidden, false},
+		{CommentStatePublished, CommentStateHidden, true},
+		{CommentStatePublished, CommentStatePending, false},
+		{CommentStatePublished, CommentStateRejected, false},
+		{CommentStateHidden, CommentStatePublished, true},
+		{CommentStateRejected, CommentStatePublished, true},
+		{CommentStateAdminDeleted, CommentStatePublished, false},
+		{CommentStatePublished, CommentStatePublished, false},
+	}
+	for _, tt := range tests {
+		if got := CanTransitCommentState(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransitCommentState(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestIsCommentVisible(t *testing.T) {
+	tests := map[int8]bool{
+		CommentStatePublished: true,
+		CommentStatePending: false,
+		CommentStateHidden: false,
+		CommentStateRejected: false,
+		CommentStateAdminDeleted: false,
+	}
+	for state, want := range tests {
+		if got := IsCommentVisible(state); got != want {
+			t.Errorf("IsCommentVisible(%d) = %v, want %v", state, got, want)
+		}
+	}
+}
+
+func TestCommentCursor(t *testing.T) {
+	tests := []*CommentCursor{
+		{Sort: CommentSortFloor, Floor: 12, Id: 1001},
+		{Sort: CommentSortHot, Id: 1002, Score: 3.1415926},
+		{Sort: CommentSortTop, Id: 1003, Score: -0.5},
+		{Sort: CommentSortHot, Id: 18446744073709551615, Score: 1e-10},
+	}
+	for _, want := range tests {
+		got, err := ParseCommentCursor(want.String())
+		if err != nil {
+			t.Errorf("ParseCommentCursor(%q) err: %v", want.String(), err)
+			continue
+		}
+		if *got != *want {
+			t.Errorf("ParseCommentCursor(%q) = %+v, want %+v", want.String(), got, want)
+		}
+	}
+}
+
+func TestParseCommentCursor(t *testing.T) {
+	tests := []struct {
+		name string
+		s string
+		wantNil bool
+		wantErr bool
+	}{
+		{"empty", "", true, false},
+		{"not base64", "!!!", false, true},
+		{"missing parts", base64.RawURLEncoding.EncodeToString([]byte("0:1:2")), false, true},
+		{"invalid sort", base64.RawURLEncoding.EncodeToString([]byte("9:1:2:0")), false, true},
+		{"invalid floor", base64.RawURLEncoding.EncodeToString([]byte("0:a:2:0")), false, true},
+		{"negative id", base64.RawURLEncoding.EncodeToString([]byte("0:1:-2:0")), false, true},
+		{"invalid score", base64.RawURLEncoding.EncodeToString([]byte("1:0:2:x")), false, true},
+		{"valid", base64.RawURLEncoding.EncodeToString([]byte("1:0:2:0.5")), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := ParseCommentCursor(tt.s)
+			if tt.wantErr {
+				if !errs.IsInvalidCursor(err) {
+					t.Errorf("err = %v, want invalid cursor", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if (cursor == nil) != tt.wantNil {
+				t.Errorf("cursor = %+v, want nil %v", cursor, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestCommentCursorStringNil(t *testing.T) {
+	var cursor *CommentCursor
+	if s := cursor.String(); s != "" {
+		t.Errorf("nil cursor String() = %q, want empty", s)
+	}
+}
+
+func TestClampPageSize(t *testing.T) {
+	tests := map[int]int{
+		-1: defaultPageSize,
+		0: defaultPageSize,
+		1: 1,
+		maxPageSize: maxPageSize,
+		maxPageSize + 1: maxPageSize,
+	}
+	for size, want := range tests {
+		if got := clampPageSize(size); got != want {
+			t.Errorf("clampPageSize(%d) = %d, want %d", size, got, want)
+		}
+	}
+}
+
+// fakeCommentRepo 只实现测试用到的方法, 调用其他方法会 panic
+type fakeCommentRepo struct {
+	CommentRepo
+	comments map[uint64]*Comment
+	subjects map[uint64]*CommentSubject
+	reactions map[uint64]string
+	queued map[uint64]*Comment
+}
+
+func (r *fakeCommentRepo) GetQueuedComment(_ context.Context, id uint64) (*Comment, error) {
+	return r.queued[id], nil
+}
+
+func (r *fakeCommentRepo) GetCommentIndex(_ context.Context, id uint64) (*Comment, error) {
+	comment, ok := r.comments[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return comment, nil
+}
+
+func (r *fakeCommentRepo) GetSubjectById(_ context.Context, id uint64) (*CommentSubject, error) {
+	subject, ok := r.subjects[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	return subject, nil
+}
+
+func (r *fakeCommentRepo) UpdateReaction(_ context.Context, id uint64, _ uint64, reaction string) error {
+	r.reactions[id] = reaction
+	return nil
+}
+
+func (r *fakeCommentRepo) UpdateCommentPinned(_ context.Context, comment *Comment, pinned bool, _ int) error {
+	comment.Pinned = pinned
+	return nil
+}
+
+func TestPinComment(t *testing.T) {
+	tests := []struct {
+		name string
+		subject CommentSubject
+		memberId uint64
+		admin bool
+		wantErr func(error) bool
+	}{
+		{"owner", CommentSubject{Id: 1, MemberId: 10}, 10, false, nil},
+		{"other member", CommentSubject{Id: 1, MemberId: 10}, 11, false, errs.IsPermissionDenied},
+		{"anonymous", CommentSubject{Id: 1, MemberId: 10}, 0, false, errs.IsPermissionDenied},
+		{"anonymous on subject without owner", CommentSubject{Id: 1}, 0, false, errs.IsPermissionDenied},
+		{"owner on locked subject", CommentSubject{Id: 1, MemberId: 10, State: SubjectStateLocked}, 10, false, errs.IsSubjectClosed},
+		{"admin", CommentSubject{Id: 1, MemberId: 10, State: SubjectStateLocked}, 0, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject := tt.subject
+			repo := &fakeCommentRepo{
+				comments: map[uint64]*Comment{
+					1: {Id: 1, SubjectId: 1, MemberId: 20, State: CommentStatePublished},
+				},
+				subjects: map[uint64]*CommentSubject{1: &subject},
+			}
+			uc := &CommentUsecase{repo: repo}
+			err := uc.PinComment(context.Background(), 1, tt.memberId, tt.admin, true)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("err = %v", err)
+				}
+				if repo.comments[1].Pinned {
+					t.Errorf("comment pinned")
+				}
+				return
+			}
+			if err != nil || !repo.comments[1].Pinned {
+				t.Errorf("pinned %v err %v", repo.comments[1].Pinned, err)
+			}
+		})
+	}
+}
+
+func TestGetCommentStatus(t *testing.T) {
+	repo := &fakeCommentRepo{
+		comments: map[uint64]*Comment{
+			1: {Id: 1, MemberId: 10, State: CommentStatePublished},
+			2: {Id: 2, MemberId: 10, State: CommentStateRejected},
+		},
+		queued: map[uint64]*Comment{
+			3: {Id: 3, MemberId: 10},
+		},
+	}
+	uc := &CommentUsecase{repo: repo}
+	tests := []struct {
+		name string
+		id uint64
+		memberId uint64
+		status int8
+		notFound bool
+	}{
+		{"persisted", 1, 10, CommentStatusPersisted, false},
+		{"rejected", 2, 10, CommentStatusRejected, false},
+		{"queued", 3, 10, CommentStatusQueued, false},
+		{"expired or discarded", 4, 10, 0, true},
+		{"persisted by another member", 1, 11, 0, true},
+		{"queued by another member", 3, 11, 0, true},
+		{"anonymous", 3, 0, 0, true},
+	}
+	for _, tt := range tests {
+		status, _, err := uc.GetCommentStatus(context.Background(), tt.id, tt.memberId)
+		if tt.notFound {
+			if !errs.IsNotFound(err) {
+				t.Errorf("%s: err %v, want not found", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || status != tt.status {
+			t.Errorf("%s: status %d err %v, want %d", tt.name, status, err, tt.status)
+		}
+	}
+}
+
+func TestReactComment(t *testing.T) {
+	repo := &fakeCommentRepo{
+		comments: map[uint64]*Comment{
+			1: {Id: 1, SubjectId: 1, State: CommentStatePublished},
+			2: {Id: 2, SubjectId: 1, State: CommentStateHidden},
+			3: {Id: 3, SubjectId: 2, State: CommentStatePublished},
+		},
+		subjects: map[uint64]*CommentSubject{
+			1: {Id: 1, State: SubjectStateClosed},
+			2: {Id: 2, State: SubjectStateLocked},
+		},
+		reactions: make(map[uint64]string),
+	}
+	uc := &CommentUsecase{
+		repo: repo,
+		reactionTypes: map[string]bool{ReactionLike: true, ReactionDislike: true, "laugh": true},
+		log: log.NewHelper(log.DefaultLogger),
+	}
+	tests := []struct {
+		name string
+		id uint64
+		reaction string
+		wantErr func(error) bool
+	}{
+		{"like", 1, ReactionLike, nil},
+		{"custom reaction", 1, "laugh", nil},
+		{"cancel", 1, "", nil},
+		{"unknown reaction", 1, "angry", errs.IsInvalidReaction},
+		{"invisible comment", 2, ReactionLike, errs.IsInvalidState},
+		{"locked subject", 3, ReactionLike, errs.IsSubjectClosed},
+		{"not found", 4, ReactionLike, errs.IsNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delete(repo.reactions, tt.id)
+			err := uc.ReactComment(context.Background(), tt.id, 100, tt.reaction)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("err = %v", err)
+				}
+				if _, ok := repo.reactions[tt.id]; ok {
+					t.Errorf("reaction saved on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got, ok := repo.reactions[tt.id]; !ok || got != tt.reaction {
+				t.Errorf("saved reaction = %q, want %q", got, tt.reaction)
+			}
+		})
+	}
+}
+
+func TestApplyAutoClose(t *testing.T) {
+	uc := &CommentUsecase{autoCloseAfter: map[int]time.Duration{1: time.Hour}}
+	old := time.Now().Add(-2 * time.Hour)
+	tests := []struct {
+		name string
+		subject CommentSubject
+		want int8
+	}{
+		{"expired", CommentSubject{ObjType: 1, CreatedAt: old}, SubjectStateClosed},
+		{"not expired", CommentSubject{ObjType: 1, CreatedAt: time.Now()}, SubjectStateOpen},
+		{"no auto close", CommentSubject{ObjType: 2, CreatedAt: old}, SubjectStateOpen},
+		{"manually opened", CommentSubject{ObjType: 1, CreatedAt: old, StateChangedAt: time.Now()}, SubjectStateOpen},
+		{"locked", CommentSubject{ObjType: 1, CreatedAt: old, State: SubjectStateLocked}, SubjectStateLocked},
+	}
+	for _, tt := range tests {
+		subject := tt.subject
+		uc.applyAutoClose(&subject)
+		if subject.State != tt.want {
+			t.Errorf("%s: state = %d, want %d", tt.name, subject.State, tt.want)
+		}
+	}
+}
+
+// fakeSubjectRepo 按 obj_id 保存主题, 用于主题状态的测试
+type fakeSubjectRepo struct {
+	fakeCommentRepo
+	byObj map[uint64]*CommentSubject
+}
+
+func (r *fakeSubjectRepo) GetSubjectByObj(_ context.Context, subject *CommentSubject) error {
+	saved, ok := r.byObj[subject.ObjId]
+	if !ok {
+		return errs.ErrNotFound
+	}
+	*subject = *saved
+	return nil
+}
+
+// CreateSubject 与 data 一致, 已存在的主题只在没有所有者时设置所有者, 并返回保存的主题
+func (r *fakeSubjectRepo) CreateSubject(_ context.Context, subject *CommentSubject) error {
+	if saved, ok := r.byObj[subject.ObjId]; ok {
+		if saved.MemberId == 0 {
+			saved.MemberId = subject.MemberId
+		}
+		*subject = *saved
+		return nil
+	}
+	saved := *subject
+	r.byObj[subject.ObjId] = &saved
+	return nil
+}
+
+func (r *fakeSubjectRepo) UpdateSubject(_ context.Context, subject *CommentSubject) error {
+	saved := *subject
+	r.byObj[subject.ObjId] = &saved
+	return nil
+}
+
+func (r *fakeSubjectRepo) UpdateSubjectState(_ context.Context, subject *CommentSubject, state int8) error {
+	r.byObj[subject.ObjId].State = state
+	subject.State = state
+	return nil
+}
+
+func TestUpdateSubjectState(t *testing.T) {
+	tests := []struct {
+		name string
+		saved *CommentSubject
+		memberId uint64
+		admin bool
+		state int8
+		wantErr func(error) bool
+	}{
+		{"owner closes", &CommentSubject{ObjId: 1, MemberId: 10}, 10, false, SubjectStateClosed, nil},
+		{"owner reopens", &CommentSubject{ObjId: 1, MemberId: 10, State: SubjectStateClosed}, 10, false, SubjectStateOpen, nil},
+		{"other member", &CommentSubject{ObjId: 1, MemberId: 10}, 11, false, SubjectStateClosed, errs.IsPermissionDenied},
+		{"owner locks", &CommentSubject{ObjId: 1, MemberId: 10}, 10, false, SubjectStateLocked, errs.IsPermissionDenied},
+		{"owner unlocks", &CommentSubject{ObjId: 1, MemberId: 10, State: SubjectStateLocked}, 10, false, SubjectStateOpen, errs.IsPermissionDenied},
+		{"anonymous", &CommentSubject{ObjId: 1}, 0, false, SubjectStateClosed, errs.IsPermissionDenied},
+		{"admin locks", &CommentSubject{ObjId: 1, MemberId: 10}, 0, true, SubjectStateLocked, nil},
+		{"admin unlocks", &CommentSubject{ObjId: 1, MemberId: 10, State: SubjectStateLocked}, 0, true, SubjectStateOpen, nil},
+		{"admin closes unregistered", nil, 0, true, SubjectStateClosed, nil},
+		{"member on unregistered", nil, 10, false, SubjectStateClosed, errs.IsPermissionDenied},
+		{"anonymous on unregistered", nil, 0, false, SubjectStateClosed, errs.IsPermissionDenied},
+		{"invalid state", &CommentSubject{ObjId: 1, MemberId: 10}, 0, true, SubjectStateLocked + 1, errs.IsInvalidState},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeSubjectRepo{byObj: make(map[uint64]*CommentSubject)}
+			if tt.saved != nil {
+				repo.byObj[tt.saved.ObjId] = tt.saved
+			}
+			uc := &CommentUsecase{repo: repo}
+			err := uc.UpdateSubjectState(context.Background(), &CommentSubject{ObjId: 1, ObjType: 1}, tt.memberId, tt.admin, tt.state)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("err = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got := repo.byObj[1].State; got != tt.state {
+				t.Errorf("state = %d, want %d", got, tt.state)
+			}
+		})
+	}
+}
+
+// fakeRateLimitRepo 记录限流规则, 返回预设的等待时间
+type fakeRateLimitRepo struct {
+	fakeCommentRepo
+	limits []*RateLimit
+	wait time.Duration
+	err error
+}
+
+func (r *fakeRateLimitRepo) TakeRateLimit(_ context.Context, limits []*RateLimit) (time.Duration, error) {
+	r.limits = limits
+	return r.wait, r.err
+}
+
+func TestTakeRateLimit(t *testing.T) {
+	memberLimit := RateLimit{Count: 5, Window: time.Minute}
+	ipLimit := RateLimit{Count: 20, Window: time.Minute}
+	subjectLimit := RateLimit{Count: 100, Window: time.Second}
+	tests := []struct {
+		name string
+		member, ip, subject RateLimit
+		comment *Comment
+		wait time.Duration
+		err error
+		wantKeys []string
+		wantLimited bool
+	}{
+		{"all limits", memberLimit, ipLimit, subjectLimit, &Comment{MemberId: 1, Ip: "10.0.0.1"}, 0, nil,
+			[]string{"member:1", "ip:10.0.0.1", "subject:7:1"}, false},
+		{"anonymous without ip", memberLimit, ipLimit, subjectLimit, &Comment{}, 0, nil,
+			[]string{"subject:7:1"}, false},
+		{"disabled limits", RateLimit{Count: 5}, RateLimit{Window: time.Minute}, subjectLimit, &Comment{MemberId: 1, Ip: "10.0.0.1"}, 0, nil,
+			[]string{"subject:7:1"}, false},
+		{"no limits", RateLimit{}, RateLimit{}, RateLimit{}, &Comment{MemberId: 1}, time.Second, nil, nil, false},
+		{"limited", memberLimit, RateLimit{}, RateLimit{}, &Comment{MemberId: 1}, time.Second, nil,
+			[]string{"member:1"}, true},
+		{"limiter unavailable", memberLimit, RateLimit{}, RateLimit{}, &Comment{MemberId: 1}, 0, errs.ErrNotFound,
+			[]string{"member:1"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRateLimitRepo{wait: tt.wait, err: tt.err}
+			uc := &CommentUsecase{
+				repo: repo,
+				memberLimit: tt.member,
+				ipLimit: tt.ip,
+				subjectLimit: tt.subject,
+				log: log.NewHelper(log.DefaultLogger),
+			}
+			err := uc.takeRateLimit(context.Background(), &CommentSubject{ObjId: 7, ObjType: 1}, tt.comment)
+			if got := errs.IsRateLimited(err); got != tt.wantLimited {
+				t.Errorf("limited = %v, want %v, err: %v", got, tt.wantLimited, err)
+			}
+			if tt.wantLimited && err.(errs.RateLimitError).RetryAfter != tt.wait {
+				t.Errorf("retry after = %v, want %v", err.(errs.RateLimitError).RetryAfter, tt.wait)
+			}
+			var keys []string
+			for _, limit := range repo.limits {
+				keys = append(keys, limit.Key)
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("keys = %v, want %v", keys, tt.wantKeys)
+			}
+		})
+	}
+}
+
+func TestCreateCommentRateLimitedBeforeSubject(t *testing.T) {
+	policies := &PolicyRegistry{}
+	if err := policies.load(&conf.Data{}); err != nil {
+		t.Fatal(err)
+	}
+	// 创建主题会调用未实现的方法而 panic
+	repo := &fakeRateLimitRepo{wait: time.Second}
+	uc := &CommentUsecase{
+		repo: repo,
+		policies: policies,
+		memberLimit: RateLimit{Count: 1, Window: time.Minute},
+		log: log.NewHelper(log.DefaultLogger),
+	}
+	err := uc.createComment(context.Background(), &CommentSubject{ObjId: 7, ObjType: 1}, &Comment{MemberId: 1, Message: "hi"})
+	if !errs.IsRateLimited(err) {
+		t.Errorf("err = %v, want rate limited", err)
+	}
+}
+
+// fakeIdempotencyRepo 请求id的记录分别保存在 redis 与 job 的表中
+type fakeIdempotencyRepo struct {
+	fakeRateLimitRepo
+	records map[string]*IdempotencyRecord
+	saved map[string]*IdempotencyRecord
+}
+
+func (r *fakeIdempotencyRepo) ClaimIdempotencyKey(_ context.Context, _ uint64, key string, fingerprint string, _ time.Duration) (*IdempotencyRecord, bool, error) {
+	if record, ok := r.records[key]; ok {
+		return record, false, nil
+	}
+	record := &IdempotencyRecord{CommentId: 100, Fingerprint: fingerprint}
+	r.records[key] = record
+	return record, true, nil
+}
+
+func (r *fakeIdempotencyRepo) SaveIdempotencyKey(_ context.Context, _ uint64, key string, record *IdempotencyRecord, _ time.Duration) error {
+	r.records[key] = record
+	return nil
+}
+
+func (r *fakeIdempotencyRepo) GetSavedIdempotencyKey(_ context.Context, _ uint64, key string) (*IdempotencyRecord, error) {
+	return r.saved[key], nil
+}
+
+func (r *fakeIdempotencyRepo) ReleaseIdempotencyKey(_ context.Context, _ uint64, key string) error {
+	delete(r.records, key)
+	return nil
+}
+
+func TestCreateCommentIdempotency(t *testing.T) {
+	policies := &PolicyRegistry{}
+	if err := policies.load(&conf.Data{}); err != nil {
+		t.Fatal(err)
+	}
+	subject := &CommentSubject{ObjId: 7, ObjType: 1}
+	fingerprint := commentFingerprint(subject, &Comment{Message: "hi"})
+	tests := []struct {
+		name string
+		record *IdempotencyRecord
+		saved *IdempotencyRecord
+		wantId uint64
+		wantErr func(error) bool
+		wantRecord *IdempotencyRecord
+	}{
+		{"duplicate", &IdempotencyRecord{CommentId: 1, Fingerprint: fingerprint, Done: true}, nil, 1, nil,
+			&IdempotencyRecord{CommentId: 1, Fingerprint: fingerprint, Done: true}},
+		{"in progress", &IdempotencyRecord{CommentId: 1, Fingerprint: fingerprint}, nil, 0, errs.IsRequestInProgress,
+			&IdempotencyRecord{CommentId: 1, Fingerprint: fingerprint}},
+		{"reused by another request", &IdempotencyRecord{CommentId: 1, Fingerprint: "other", Done: true}, nil, 0, errs.IsIdempotencyKeyReused,
+			&IdempotencyRecord{CommentId: 1, Fingerprint: "other", Done: true}},
+		{"legacy record without fingerprint", &IdempotencyRecord{CommentId: 1, Done: true}, nil, 1, nil,
+			&IdempotencyRecord{CommentId: 1, Done: true}},
+		{"window expired and saved by job", nil, &IdempotencyRecord{CommentId: 2, Fingerprint: fingerprint, Done: true}, 2, nil,
+			&IdempotencyRecord{CommentId: 2, Fingerprint: fingerprint, Done: true}},
+		{"window expired and reused", nil, &IdempotencyRecord{CommentId: 2, Fingerprint: "other", Done: true}, 0, errs.IsIdempotencyKeyReused,
+			&IdempotencyRecord{CommentId: 2, Fingerprint: "other", Done: true}},
+		// 限流使第一次请求失败, 请求id被释放
+		{"first request failed", nil, nil, 100, errs.IsRateLimited, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeIdempotencyRepo{
+				fakeRateLimitRepo: fakeRateLimitRepo{wait: time.Second},
+				records: make(map[string]*IdempotencyRecord),
+				saved: make(map[string]*IdempotencyRecord),
+			}
+			if tt.record != nil {
+				repo.records["key"] = tt.record
+			}
+			if tt.saved != nil {
+				repo.saved["key"] = tt.saved
+			}
+			uc := &CommentUsecase{
+				repo: repo,
+				policies: policies,
+				memberLimit: RateLimit{Count: 1, Window: time.Minute},
+				log: log.NewHelper(log.DefaultLogger),
+			}
+			comment := &Comment{MemberId: 1, Message: "hi", IdempotencyKey: "key"}
+			err := uc.CreateComment(context.Background(), subject, comment)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("err = %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if comment.Id != tt.wantId {
+				t.Errorf("id = %d, want %d", comment.Id, tt.wantId)
+			}
+			if got := repo.records["key"]; !reflect.DeepEqual(got, tt.wantRecord) {
+				t.Errorf("record = %+v, want %+v", got, tt.wantRecord)
+			}
+		})
+	}
+}
+
+func TestCommentFingerprint(t *testing.T) {
+	subject := &CommentSubject{ObjId: 7, ObjType: 1}
+	base := commentFingerprint(subject, &Comment{Root: 1, Parent: 2, Message: "hi"})
+	tests := []struct {
+		name string
+		subject *CommentSubject
+		comment *Comment
+		same bool
+	}{
+		{"same request", subject, &Comment{Root: 1, Parent: 2, Message: "hi", MemberId: 3, Ip: "10.0.0.1"}, true},
+		{"other subject", &CommentSubject{ObjId: 8, ObjType: 1}, &Comment{Root: 1, Parent: 2, Message: "hi"}, false},
+		{"other obj_type", &CommentSubject{ObjId: 7, ObjType: 2}, &Comment{Root: 1, Parent: 2, Message: "hi"}, false},
+		{"other parent", subject, &Comment{Root: 1, Parent: 3, Message: "hi"}, false},
+		{"other message", subject, &Comment{Root: 1, Parent: 2, Message: "hello"}, false},
+		{"other meta", subject, &Comment{Root: 1, Parent: 2, Message: "hi", Meta: "{}"}, false},
+	}
+	for _, tt := range tests {
+		if got := commentFingerprint(tt.subject, tt.comment) == base; got != tt.same {
+			t.Errorf("%s: same = %v, want %v", tt.name, got, tt.same)
+		}
+	}
+}
+
+func TestNormalizeMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		maxMentions int
+		message string
+		mentions []Mention
+		want []Mention
+		wantIds string
+	}{
+		{"none", 0, "hello", nil, nil, ""},
+		{"single", 0, "hi @bob", []Mention{{2, 3, 7}}, []Mention{{2, 3, 7}}, "2"},
+		{"sorted by start", 0, "@a @b", []Mention{{2, 3, 5}, {1, 0, 2}}, []Mention{{1, 0, 2}, {2, 3, 5}}, "1,2"},
+		{"duplicate member", 0, "@a @a", []Mention{{1, 0, 2}, {1, 3, 5}}, []Mention{{1, 0, 2}, {1, 3, 5}}, "1"},
+		{"overlapping", 0, "@abc", []Mention{{1, 0, 4}, {2, 0, 2}}, []Mention{{1, 0, 4}}, "1"},
+		{"not starting with @", 0, "a@b", []Mention{{1, 0, 3}}, nil, ""},
+		{"only @", 0, "@ b", []Mention{{1, 0, 1}}, nil, ""},
+		{"out of range", 0, "@a", []Mention{{1, 0, 3}}, nil, ""},
+		{"negative start", 0, "@a", []Mention{{1, -1, 2}}, nil, ""},
+		{"zero member", 0, "@a", []Mention{{0, 0, 2}}, nil, ""},
+		{"unicode positions", 0, "你好@小明 再见", []Mention{{3, 2, 5}}, []Mention{{3, 2, 5}}, "3"},
+		{"max mentions", 2, "@a @b @c", []Mention{{1, 0, 2}, {2, 3, 5}, {3, 6, 8}}, []Mention{{1, 0, 2}, {2, 3, 5}}, "1,2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &CommentUsecase{maxMentions: tt.maxMentions}
+			comment := &Comment{Message: tt.message, Mentions: tt.mentions}
+			uc.normalizeMentions(comment)
+			if !reflect.DeepEqual(comment.Mentions, tt.want) {
+				t.Errorf("mentions = %v, want %v", comment.Mentions, tt.want)
+			}
+			if comment.AtMemberIds != tt.wantIds {
+				t.Errorf("AtMemberIds = %q, want %q", comment.AtMemberIds, tt.wantIds)
+			}
+		})
+	}
+}
+
+func TestValidateSubjectInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		subject CommentSubject
+		valid bool
+	}{
+		{"empty", CommentSubject{}, true},
+		{"max title", CommentSubject{Title: strings.Repeat("a", maxSubjectTitleLength)}, true},
+		{"title too long", CommentSubject{Title: strings.Repeat("a", maxSubjectTitleLength + 1)}, false},
+		{"title counted in runes", CommentSubject{Title: strings.Repeat("标", maxSubjectTitleLength)}, true},
+		{"max url", CommentSubject{Url: strings.Repeat("u", maxSubjectUrlLength)}, true},
+		{"url too long", CommentSubject{Url: strings.Repeat("u", maxSubjectUrlLength + 1)}, false},
+		{"attributes", CommentSubject{Attributes: map[string]string{"cover": "a.png"}}, true},
+		// {"k":"..."} 编码后多出 8 个字节
+		{"max attributes", CommentSubject{Attributes: map[string]string{"k": strings.Repeat("v", maxSubjectAttributesLength - 8)}}, true},
+		{"attributes too long", CommentSubject{Attributes: map[string]string{"k": strings.Repeat("v", maxSubjectAttributesLength - 7)}}, false},
+		{"attributes counted in encoded bytes", CommentSubject{Attributes: map[string]string{"k": strings.Repeat("\"", maxSubjectAttributesLength / 2)}}, false},
+	}
+	for _, tt := range tests {
+		err := validateSubjectInfo(&tt.subject)
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected err: %v", tt.name, err)
+		}
+		if !tt.valid && !errs.IsInvalidSubject(err) {
+			t.Errorf("%s: err = %v, want invalid subject", tt.name, err)
+		}
+	}
+}
+
+func TestSameInfo(t *testing.T) {
+	base := CommentSubject{Title: "t", Url: "u", Attributes: map[string]string{"a": "1"}}
+	tests := []struct {
+		name string
+		other CommentSubject
+		want bool
+	}{
+		{"same", CommentSubject{Title: "t", Url: "u", Attributes: map[string]string{"a": "1"}}, true},
+		{"other fields ignored", CommentSubject{Id: 2, MemberId: 3, State: SubjectStateClosed, Title: "t", Url: "u",
+			Attributes: map[string]string{"a": "1"}}, true},
+		{"title", CommentSubject{Title: "x", Url: "u", Attributes: map[string]string{"a": "1"}}, false},
+		{"url", CommentSubject{Title: "t", Url: "x", Attributes: map[string]string{"a": "1"}}, false},
+		{"attribute value", CommentSubject{Title: "t", Url: "u", Attributes: map[string]string{"a": "2"}}, false},
+		{"attribute key", CommentSubject{Title: "t", Url: "u", Attributes: map[string]string{"b": "1"}}, false},
+		{"extra attribute", CommentSubject{Title: "t", Url: "u", Attributes: map[string]string{"a": "1", "b": "2"}}, false},
+		{"no attributes", CommentSubject{Title: "t", Url: "u"}, false},
+	}
+	for _, tt := range tests {
+		if got := base.sameInfo(&tt.other); got != tt.want {
+			t.Errorf("%s: sameInfo = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := tt.other.sameInfo(&base); got != tt.want {
+			t.Errorf("%s: reversed sameInfo = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	var empty CommentSubject
+	if !empty.sameInfo(&CommentSubject{Attributes: map[string]string{}}) {
+		t.Errorf("nil and empty attributes should be the same")
+	}
+}
+
+func TestRegisterSubject(t *testing.T) {
+	tests := []struct {
+		name string
+		saved *CommentSubject
+		subject CommentSubject
+		want *CommentSubject
+		wantErr func(error) bool
+	}{
+		{"new subject", nil, CommentSubject{ObjId: 1, MemberId: 10, Title: "t"},
+			&CommentSubject{ObjId: 1, MemberId: 10, Title: "t"}, nil},
+		{"claim unowned", &CommentSubject{ObjId: 1, Title: "old"}, CommentSubject{ObjId: 1, MemberId: 10, Title: "t"},
+			&CommentSubject{ObjId: 1, MemberId: 10, Title: "t"}, nil},
+		{"owner updates info", &CommentSubject{ObjId: 1, MemberId: 10, Title: "old", State: SubjectStateClosed},
+			CommentSubject{ObjId: 1, MemberId: 10, Title: "t", Url: "u"},
+			&CommentSubject{ObjId: 1, MemberId: 10, Title: "t", Url: "u", State: SubjectStateClosed}, nil},
+		{"other owner", &CommentSubject{ObjId: 1, MemberId: 11, Title: "old"}, CommentSubject{ObjId: 1, MemberId: 10, Title: "t"},
+			&CommentSubject{ObjId: 1, MemberId: 11, Title: "old"}, errs.IsPermissionDenied},
+		{"invalid info", nil, CommentSubject{ObjId: 1, MemberId: 10, Title: strings.Repeat("a", maxSubjectTitleLength + 1)},
+			nil, errs.IsInvalidSubject},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeSubjectRepo{byObj: make(map[uint64]*CommentSubject)}
+			if tt.saved != nil {
+				repo.byObj[tt.saved.ObjId] = tt.saved
+			}
+			uc := &CommentUsecase{repo: repo}
+			subject := tt.subject
+			err := uc.RegisterSubject(context.Background(), &subject)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("err = %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got := repo.byObj[1]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("saved = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSubject(t *testing.T) {
+	saved := CommentSubject{ObjId: 1, MemberId: 10, Title: "old"}
+	tests := []struct {
+		name string
+		memberId uint64
+		admin bool
+		ownerId uint64
+		want CommentSubject
+		wantErr func(error) bool
+	}{
+		{"owner updates info", 10, false, 0, CommentSubject{ObjId: 1, MemberId: 10, Title: "t"}, nil},
+		{"other member", 11, false, 0, saved, errs.IsPermissionDenied},
+		{"anonymous", 0, false, 0, saved, errs.IsPermissionDenied},
+		{"anonymous transfers owner", 0, false, 12, saved, errs.IsPermissionDenied},
+		{"owner transfers owner", 10, false, 12, saved, errs.IsPermissionDenied},
+		{"admin transfers owner", 0, true, 12, CommentSubject{ObjId: 1, MemberId: 12, Title: "t"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject := saved
+			repo := &fakeSubjectRepo{byObj: map[uint64]*CommentSubject{1: &subject}}
+			uc := &CommentUsecase{repo: repo}
+			err := uc.UpdateSubject(context.Background(), &CommentSubject{ObjId: 1, Title: "t"}, tt.memberId, tt.admin, tt.ownerId)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("err = %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got := repo.byObj[1]; !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("saved = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 通知类型, 通知由 job 在保存评论与点赞后写入
+const (
+	NotificationTypeReply int8 = iota + 1 // 评论被回复
+	NotificationTypeMention               // 在评论中被 @
+	NotificationTypeLike                  // 评论被点赞, 未读期间的点赞合并为一条
+)
+
+// Notification 成员收到的一条通知
+type Notification struct {
+	Id uint64
+	MemberId uint64
+	Type int8
+	CommentId uint64 // 回复与 @ 为新评论的id, 点赞为被点赞的评论id
+	ObjId uint64
+	ObjType int
+	ActorId uint64 // 最近一次触发通知的成员
+	ActorCount int
+	Read bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// maxNotificationPageSize 每页最多返回的通知数
+const maxNotificationPageSize = 50
+
+type NotificationRepo interface {
+	ListNotification(ctx context.Context, memberId uint64, page, size int) ([]*Notification, error)
+	// ReadNotification ids 为空时全部标记为已读
+	ReadNotification(ctx context.Context, memberId uint64, ids []uint64) error
+	GetUnreadNotificationCount(ctx context.Context, memberId uint64) (int, error)
+}
+
+type NotificationUsecase struct {
+	repo NotificationRepo
+	log *log.Helper
+}
+
+func NewNotificationUsecase(repo NotificationRepo, logger log.Logger) *NotificationUsecase {
+	return &NotificationUsecase{
+		repo: repo,
+		log: log.NewHelper(logger),
+	}
+}
+
+// ListNotification 查询成员收到的通知, 按最近更新时间倒序
+func (uc *NotificationUsecase) ListNotification(ctx context.Context, memberId uint64, page, size int) ([]*Notification, error) {
+	if size <= 0 || size > maxNotificationPageSize {
+		size = maxNotificationPageSize
+	}
+	return uc.repo.ListNotification(ctx, memberId, page, size)
+}
+
+// ReadNotification 将成员的通知标记为已读, ids 为空时全部标记为已读
+func (uc *NotificationUsecase) ReadNotification(ctx context.Context, memberId uint64, ids []uint64) error {
+	return uc.repo.ReadNotification(ctx, memberId, ids)
+}
+
+// GetUnreadNotificationCount 成员未读的通知数
+func (uc *NotificationUsecase) GetUnreadNotificationCount(ctx context.Context, memberId uint64) (int, error) {
+	return uc.repo.GetUnreadNotificationCount(ctx, memberId)
+}
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"base-service/app/comment/service/internal/conf"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"sync/atomic"
+)
+
+// 审核方式
+const (
+	ModerationPre = "pre"   // 先审后发, 新评论进入待审核状态
+	ModerationPost = "post" // 先发后审
+)
+
+// builtinPolicy 未配置策略时的行为
+var builtinPolicy = &conf.Data_Policy{
+	MaxMessageLength: proto.Int32(0),
+	MaxReplyDepth: proto.Int32(0),
+	DefaultSort: proto.Int32(CommentSortFloor),
+	Moderation: proto.String(ModerationPost),
+	AnonymousRead: proto.Bool(true),
+	ReplyPreviewCount: proto.Int32(0),
+}
+
+// CommentPolicy 按 obj_type 区分的评论行为
+type CommentPolicy struct {
+	MaxMessageLength int // 评论内容的最大字符数, 为0时不限制
+	MaxReplyDepth int // 评论的最大层级, 1 只允许根评论, 2 允许回复根评论, 为0时不限制
+	DefaultSort int
+	Moderation string
+	AnonymousRead bool
+	ReplyPreviewCount int
+}
+
+// AllowDepth 是否允许发表该层级的评论
+func (p *CommentPolicy) AllowDepth(depth int) bool {
+	return p.MaxReplyDepth <= 0 || depth <= p.MaxReplyDepth
+}
+
+type policySet struct {
+	defaults *CommentPolicy
+	objTypes map[int]*CommentPolicy
+}
+
+// PolicyRegistry 按 obj_type 查询评论策略, 配置文件修改后重新加载, 加载失败时继续使用旧的策略
+type PolicyRegistry struct {
+	policies atomic.Value // *policySet
+	log *log.Helper
+}
+
+func NewPolicyRegistry(c *conf.Data, source config.Config, logger log.Logger) (*PolicyRegistry, error) {
+	r := &PolicyRegistry{log: log.NewHelper(logger)}
+	if err := r.load(c); err != nil {
+		return nil, err
+	}
+	if err := source.Watch("data", r.reload); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Get 查询 obj_type 的评论策略, 未单独配置的 obj_type 使用默认策略
+func (r *PolicyRegistry) Get(objType int) *CommentPolicy {
+	set := r.policies.Load().(*policySet)
+	if policy, ok := set.objTypes[objType]; ok {
+		return policy
+	}
+	return set.defaults
+}
+
+func (r *PolicyRegistry) reload(_ string, value config.Value) {
+	var c conf.Data
+	if err := value.Scan(&c); err != nil {
+		r.log.Errorf("scan comment policies err: %v", err)
+		return
+	}
+	if err := r.load(&c); err != nil {
+		r.log.Errorf("reload comment policies err: %v", err)
+		return
+	}
+	r.log.Infof("reloaded comment policies")
+}
+
+// load 依次以内置策略、默认策略、moderation.pre_obj_types 与 obj_type 的策略覆盖, 全部合法时才替换
+func (r *PolicyRegistry) load(c *conf.Data) error {
+	base := proto.Clone(builtinPolicy).(*conf.Data_Policy)
+	if d := c.GetPolicies().GetDefaults(); d != nil {
+		proto.Merge(base, d)
+	}
+	defaults, err := newCommentPolicy(base)
+	if err != nil {
+		return fmt.Errorf("defaults: %w", err)
+	}
+	overrides := make(map[int32]*conf.Data_Policy)
+	for _, objType := range c.GetModeration().GetPreObjTypes() {
+		overrides[objType] = &conf.Data_Policy{Moderation: proto.String(ModerationPre)}
+	}
+	for objType, p := range c.GetPolicies().GetObjTypes() {
+		override, ok := overrides[objType]
+		if !ok {
+			override = &conf.Data_Policy{}
+			overrides[objType] = override
+		}
+		if p != nil {
+			proto.Merge(override, p)
+		}
+	}
+	set := &policySet{defaults: defaults, objTypes: make(map[int]*CommentPolicy, len(overrides))}
+	for objType, override := range overrides {
+		p := proto.Clone(base).(*conf.Data_Policy)
+		proto.Merge(p, override)
+		policy, err := newCommentPolicy(p)
+		if err != nil {
+			return fmt.Errorf("obj_type %d: %w", objType, err)
+		}
+		set.objTypes[int(objType)] = policy
+	}
+	r.policies.Store(set)
+	return nil
+}
+
+func newCommentPolicy(p *conf.Data_Policy) (*CommentPolicy, error) {
+	policy := &CommentPolicy{
+		MaxMessageLength: int(p.GetMaxMessageLength()),
+		MaxReplyDepth: int(p.GetMaxReplyDepth()),
+		DefaultSort: int(p.GetDefaultSort()),
+		Moderation: p.GetModeration(),
+		AnonymousRead: p.GetAnonymousRead(),
+		ReplyPreviewCount: int(p.GetReplyPreviewCount()),
+	}
+	switch {
+	case policy.MaxMessageLength < 0:
+		return nil, fmt.Errorf("invalid max_message_length %d", policy.MaxMessageLength)
+	case policy.MaxReplyDepth < 0:
+		return nil, fmt.Errorf("invalid max_reply_depth %d", policy.MaxReplyDepth)
+	case !IsValidCommentSort(policy.DefaultSort):
+		return nil, fmt.Errorf("invalid default_sort %d", policy.DefaultSort)
+	case policy.Moderation != ModerationPre && policy.Moderation != ModerationPost:
+		return nil, fmt.Errorf("invalid moderation %q", policy.Moderation)
+	case policy.ReplyPreviewCount < 0:
+		return nil, fmt.Errorf("invalid reply_preview_count %d", policy.ReplyPreviewCount)
+	}
+	return policy, nil
+}
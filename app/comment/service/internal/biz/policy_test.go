@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"base-service/app/comment/service/internal/conf"
+	"errors"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"testing"
+)
+
+func TestPolicyRegistryLoad(t *testing.T) {
+	builtin := CommentPolicy{DefaultSort: CommentSortFloor, Moderation: ModerationPost, AnonymousRead: true}
+	defaults := &conf.Data_Policy{
+		MaxMessageLength: proto.Int32(500),
+		MaxReplyDepth: proto.Int32(2),
+		DefaultSort: proto.Int32(CommentSortHot),
+		ReplyPreviewCount: proto.Int32(3),
+	}
+	withDefaults := CommentPolicy{MaxMessageLength: 500, MaxReplyDepth: 2, DefaultSort: CommentSortHot,
+		Moderation: ModerationPost, AnonymousRead: true, ReplyPreviewCount: 3}
+	tests := []struct {
+		name string
+		c *conf.Data
+		objType int
+		want CommentPolicy
+	}{
+		{"builtin", &conf.Data{}, 1, builtin},
+		{"defaults over builtin", &conf.Data{Policies: &conf.Data_Policies{Defaults: defaults}}, 1, withDefaults},
+		{"pre obj_types over defaults", &conf.Data{
+			Moderation: &conf.Data_Moderation{PreObjTypes: []int32{2}},
+			Policies: &conf.Data_Policies{Defaults: defaults},
+		}, 2, func() CommentPolicy {
+			p := withDefaults
+			p.Moderation = ModerationPre
+			return p
+		}()},
+		{"pre obj_types leave others", &conf.Data{
+			Moderation: &conf.Data_Moderation{PreObjTypes: []int32{2}},
+			Policies: &conf.Data_Policies{Defaults: defaults},
+		}, 1, withDefaults},
+		{"obj_type over pre obj_types", &conf.Data{
+			Moderation: &conf.Data_Moderation{PreObjTypes: []int32{2}},
+			Policies: &conf.Data_Policies{Defaults: defaults, ObjTypes: map[int32]*conf.Data_Policy{
+				2: {Moderation: proto.String(ModerationPost)},
+			}},
+		}, 2, withDefaults},
+		{"obj_type keeps pre obj_types", &conf.Data{
+			Moderation: &conf.Data_Moderation{PreObjTypes: []int32{2}},
+			Policies: &conf.Data_Policies{ObjTypes: map[int32]*conf.Data_Policy{
+				2: {MaxMessageLength: proto.Int32(100)},
+			}},
+		}, 2, func() CommentPolicy {
+			p := builtin
+			p.MaxMessageLength = 100
+			p.Moderation = ModerationPre
+			return p
+		}()},
+		{"obj_type partial override", &conf.Data{
+			Policies: &conf.Data_Policies{Defaults: defaults, ObjTypes: map[int32]*conf.Data_Policy{
+				3: {AnonymousRead: proto.Bool(false)},
+			}},
+		}, 3, func() CommentPolicy {
+			p := withDefaults
+			p.AnonymousRead = false
+			return p
+		}()},
+		{"obj_type explicit zero", &conf.Data{
+			Policies: &conf.Data_Policies{Defaults: defaults, ObjTypes: map[int32]*conf.Data_Policy{
+				3: {MaxReplyDepth: proto.Int32(0), DefaultSort: proto.Int32(CommentSortFloor)},
+			}},
+		}, 3, func() CommentPolicy {
+			p := withDefaults
+			p.MaxReplyDepth = 0
+			p.DefaultSort = CommentSortFloor
+			return p
+		}()},
+		{"empty obj_type policy", &conf.Data{
+			Policies: &conf.Data_Policies{Defaults: defaults, ObjTypes: map[int32]*conf.Data_Policy{3: nil}},
+		}, 3, withDefaults},
+	}
+	for _, tt := range tests {
+		r := &PolicyRegistry{}
+		if err := r.load(tt.c); err != nil {
+			t.Errorf("%s: unexpected err: %v", tt.name, err)
+			continue
+		}
+		if got := r.Get(tt.objType); !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("%s: policy = %+v, want %+v", tt.name, *got, tt.want)
+		}
+	}
+}
+
+func TestPolicyRegistryLoadInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		policy *conf.Data_Policy
+	}{
+		{"negative max_message_length", &conf.Data_Policy{MaxMessageLength: proto.Int32(-1)}},
+		{"negative max_reply_depth", &conf.Data_Policy{MaxReplyDepth: proto.Int32(-1)}},
+		{"unknown default_sort", &conf.Data_Policy{DefaultSort: proto.Int32(CommentSortTop + 1)}},
+		{"unknown moderation", &conf.Data_Policy{Moderation: proto.String("later")}},
+		{"empty moderation", &conf.Data_Policy{Moderation: proto.String("")}},
+		{"negative reply_preview_count", &conf.Data_Policy{ReplyPreviewCount: proto.Int32(-1)}},
+	}
+	for _, tt := range tests {
+		configs := map[string]*conf.Data{
+			"defaults": {Policies: &conf.Data_Policies{Defaults: tt.policy}},
+			"obj_type": {Policies: &conf.Data_Policies{ObjTypes: map[int32]*conf.Data_Policy{1: tt.policy}}},
+		}
+		for where, c := range configs {
+			if err := (&PolicyRegistry{}).load(c); err == nil {
+				t.Errorf("%s in %s: want err", tt.name, where)
+			}
+		}
+	}
+}
+
+// dataValue 以 conf.Data 作为配置变更后的值
+type dataValue struct {
+	config.Value
+	data *conf.Data
+	err error
+}
+
+func (v dataValue) Scan(out interface{}) error {
+	if v.err != nil {
+		return v.err
+	}
+	proto.Merge(out.(*conf.Data), v.data)
+	return nil
+}
+
+func TestPolicyRegistryReload(t *testing.T) {
+	policy := func(length int32) *conf.Data {
+		return &conf.Data{Policies: &conf.Data_Policies{Defaults: &conf.Data_Policy{MaxMessageLength: proto.Int32(length)}}}
+	}
+	r := &PolicyRegistry{log: log.NewHelper(log.DefaultLogger)}
+	if err := r.load(policy(100)); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		value dataValue
+		want int
+	}{
+		{"invalid config keeps the old set", dataValue{data: policy(-1)}, 100},
+		{"scan error keeps the old set", dataValue{err: errors.New("bad yaml")}, 100},
+		{"valid config replaces", dataValue{data: policy(200)}, 200},
+		{"invalid obj_type keeps the whole old set", dataValue{data: &conf.Data{Policies: &conf.Data_Policies{
+			Defaults: &conf.Data_Policy{MaxMessageLength: proto.Int32(300)},
+			ObjTypes: map[int32]*conf.Data_Policy{1: {Moderation: proto.String("later")}},
+		}}}, 200},
+	}
+	for _, tt := range tests {
+		r.reload("data", tt.value)
+		if got := r.Get(1).MaxMessageLength; got != tt.want {
+			t.Errorf("%s: max_message_length = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
@@ -1,14 +1,19 @@
 package data
 
 import (
+	jobV1 "base-service/api/comment/job/v1"
 	"base-service/app/comment/service/internal/biz"
+	"base-service/app/comment/service/internal/pkg/errs"
 	"base-service/pkg/orm"
 	"context"
 	"encoding/json"
 	"fmt"
 	mapset "github.com/deckarep/golang-set"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
 	"time"
 )
 
@@ -21,22 +26,27 @@ type CommentSubject struct {
 	RootCount int `gorm:"default:0"`
 	AllCount int `gorm:"default:0"`
 	State int8 `gorm:"default:0"`
+	StateChangedAt *time.Time
+	Title string `gorm:"size:255;not null;default:''"`
+	Url string `gorm:"size:1024;not null;default:''"`
+	Attributes string `gorm:"size:4096;not null;default:''"` // 扩展属性, json 对象
 }
 
 type CommentIndex struct {
 	orm.Model
-	SubjectId uint64
-	ObjId uint64
-	ObjType int
+	SubjectId uint64 `gorm:"index:idx_comment_index_subject,priority:1"`
+	ObjId uint64 `gorm:"index:idx_comment_index_obj,priority:1"`
+	ObjType int `gorm:"index:idx_comment_index_obj,priority:2"`
 	MemberId uint64
-	Root uint64 `gorm:"default:0"`
+	Root uint64 `gorm:"default:0;index:idx_comment_index_obj,priority:3;index:idx_comment_index_subject,priority:2"`
 	Parent uint64 `gorm:"default:0"`
-	Floor int `gorm:"default:0"`
+	Floor int `gorm:"default:0;index:idx_comment_index_obj,priority:4;index:idx_comment_index_subject,priority:3"`
 	Count int `gorm:"default:0"`
 	RootCount int `gorm:"default:0"`
 	Like int `gorm:"default:0"`
 	Hate int `gorm:"default:0"`
 	State int8 `gorm:"default:0"`
+	PinnedAt *time.Time
 	Content CommentContent `gorm:"foreignKey:Id"`
 }
 
@@ -56,26 +66,49 @@ type CommentContent struct {
 	Device string
 	Message string
 	Meta string
+	EditedAt *time.Time
+	Mentions string `gorm:"type:text"` // 评论中 @ 的成员, json 数组
+}
+
+// CommentContentRevision 评论内容被编辑前的历史版本
+type CommentContentRevision struct {
+	orm.Model
+	CommentId uint64 `gorm:"index"`
+	Message string
+	Meta string
 }
 
-type CommentLike struct {
+// CommentReaction 成员对评论的表态, 每个成员对一条评论只有一条记录
+type CommentReaction struct {
 	MemberId uint64 `gorm:"primaryKey;autoIncrement:false"`
-	CommentId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	CommentId uint64 `gorm:"primaryKey;autoIncrement:false;index"`
+	Reaction string `gorm:"size:32"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt
 }
 
-type SaveCommentMessage struct {
-	Subject biz.CommentSubject
-	Comment biz.Comment
+// CommentReactionCount 评论各表态类型的计数
+type CommentReactionCount struct {
+	CommentId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Reaction string `gorm:"primaryKey;size:32"`
+	Count int
 }
 
-type CommentIndexCacheMessage struct {
-	ObjId uint64
-	ObjType int
-	Page int
-	Size int
+// CommentFilterHit 评论命中的敏感词, 表由 job 创建并在保存评论时写入
+type CommentFilterHit struct {
+	CommentId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Policy int8
+	Hits string `gorm:"type:text"` // 命中详情, json 数组
+	CreatedAt time.Time
+}
+
+// CommentIdempotency job 保存评论时记录的请求id, 表由 job 创建并定期清理
+type CommentIdempotency struct {
+	MemberId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	IdempotencyKey string `gorm:"primaryKey;size:64"`
+	CommentId uint64
+	Fingerprint string `gorm:"size:64"`
+	CreatedAt time.Time
 }
 
 // 表明定义
@@ -92,8 +125,24 @@ func (CommentContent) TableName() string {
 	return "comment_content"
 }
 
-func (CommentLike) TableName() string {
-	return "comment_like"
+func (CommentContentRevision) TableName() string {
+	return "comment_content_revision"
+}
+
+func (CommentReaction) TableName() string {
+	return "comment_reaction"
+}
+
+func (CommentReactionCount) TableName() string {
+	return "comment_reaction_count"
+}
+
+func (CommentIdempotency) TableName() string {
+	return "comment_idempotency"
+}
+
+func (CommentFilterHit) TableName() string {
+	return "comment_filter_hit"
 }
 
 // repo 定义 实现
@@ -111,20 +160,83 @@ func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
 	}
 }
 
+// subjectMissTTL 不存在的主题的缓存时长, 挡住对任意 obj_id 的查询
+const subjectMissTTL = time.Minute
+
+// subjectMissKey 不存在的主题
+func subjectMissKey(objId uint64, objType int) string {
+	return fmt.Sprintf("csm:%d:%d", objId, objType)
+}
+
+// subjectVersionKey 主题创建的次数, 创建主题后递增, 查询期间主题被创建时不写入 subjectMissKey
+func subjectVersionKey(objId uint64, objType int) string {
+	return fmt.Sprintf("csv:%d:%d", objId, objType)
+}
+
+// subjectMissScript 查询数据库前后创建次数一致时才缓存不存在的主题
+// KEYS[1] subjectMissKey, KEYS[2] subjectVersionKey, ARGV[1] 查询前的创建次数, ARGV[2] 缓存毫秒数
+var subjectMissScript = redis.NewScript(`
+local version = redis.call('GET', KEYS[2]) or ''
+if version ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], 1, 'PX', ARGV[2])
+return 1
+`)
+
+// invalidateSubjectMiss 主题创建提交后删除不存在的缓存, 并使查询中的请求不再写入
+// 创建次数只需要比进行中的查询存在得久, 与缓存时长一致
+func invalidateSubjectMiss(ctx context.Context, rdb *redis.Client, objId uint64, objType int) error {
+	pipe := rdb.TxPipeline()
+	pipe.Incr(ctx, subjectVersionKey(objId, objType))
+	pipe.Expire(ctx, subjectVersionKey(objId, objType), subjectMissTTL)
+	pipe.Del(ctx, subjectMissKey(objId, objType))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// CreateSubject 主题不存在时按 subject 创建, 已存在且没有所有者时设置所有者为 subject.MemberId, 并返回保存的主题
 func (c commentRepo) CreateSubject(ctx context.Context, subject *biz.CommentSubject) error {
-	cs := CommentSubject{
-		ObjId:     subject.ObjId,
-		ObjType:   subject.ObjType,
-		MemberId:  subject.MemberId,
-		Count:     subject.Count,
-		RootCount: subject.RootCount,
-		AllCount:  subject.AllCount,
-		State:     subject.State,
-	}
-	cs.Id = orm.NextId()
-	result := c.data.db.WithContext(ctx).Create(&cs)
-	subject.Id = cs.Id
-	return result.Error
+	sbj, err := c.queryOrCreateSubject(ctx, subject)
+	if err != nil {
+		return err
+	}
+	if err = invalidateSubjectMiss(ctx, c.data.redisDB, subject.ObjId, subject.ObjType); err != nil {
+		c.log.Errorf("invalidate subject miss %d-%d err: %v", subject.ObjId, subject.ObjType, err)
+	}
+	if sbj.MemberId == 0 && subject.MemberId != 0 {
+		// 只在没有所有者时设置, 并发登记时以先提交的为准
+		result := c.data.db.WithContext(ctx).Model(&CommentSubject{}).
+			Where("id = ? AND member_id = 0", sbj.Id).
+			Update("member_id", subject.MemberId)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result = c.data.db.WithContext(ctx).First(sbj, sbj.Id); result.Error != nil {
+			return result.Error
+		}
+	}
+	copyCommentSubject(subject, sbj)
+	return nil
+}
+
+// UpdateSubject 更新主题的所有者、标题、链接与扩展属性
+func (c commentRepo) UpdateSubject(ctx context.Context, subject *biz.CommentSubject) error {
+	sbj := CommentSubject{}
+	sbj.Id = subject.Id
+	result := c.data.db.WithContext(ctx).Model(&sbj).Updates(orm.UpdateFields{
+		"member_id": subject.MemberId,
+		"title": subject.Title,
+		"url": subject.Url,
+		"attributes": encodeAttributes(subject.Attributes),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
 }
 
 
@@ -145,17 +257,36 @@ func (c commentRepo) ListCommentSubject(ctx context.Context, objIds []uint64, ob
 	return subjects, nil
 }
 
-// GetSubjectByObj 查询评论主题, 不存在则插入数据并返回
+// GetSubjectByObj 查询评论主题, 不存在时返回 ErrNotFound 并缓存一段时间
 func (c commentRepo) GetSubjectByObj(ctx context.Context, subject *biz.CommentSubject) error {
-	sbj, err := c.queryOrCreateSubject(ctx, subject.ObjId, subject.ObjType, subject.MemberId)
-	if err != nil {
-		return err
+	missKey := subjectMissKey(subject.ObjId, subject.ObjType)
+	versionKey := subjectVersionKey(subject.ObjId, subject.ObjType)
+	values, cacheErr := c.data.redisDB.MGet(ctx, missKey, versionKey).Result()
+	if cacheErr == nil && values[0] != nil {
+		return errs.ErrNotFound
 	}
-	copyCommentSubject(subject, sbj)
+	var sbj CommentSubject
+	result := c.data.db.WithContext(ctx).
+		Where("obj_id = ? AND obj_type = ?", subject.ObjId, subject.ObjType).
+		First(&sbj)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			// 读取创建次数失败时不缓存
+			if cacheErr == nil {
+				version, _ := values[1].(string)
+				subjectMissScript.Run(ctx, c.data.redisDB, []string{missKey, versionKey},
+					version, subjectMissTTL.Milliseconds())
+			}
+			return errs.ErrNotFound
+		}
+		return result.Error
+	}
+	copyCommentSubject(subject, &sbj)
 	return nil
 }
 
-func (c commentRepo) queryOrCreateSubject(ctx context.Context, objId uint64, objType int, memberId uint64) (*CommentSubject, error) {
+func (c commentRepo) queryOrCreateSubject(ctx context.Context, subject *biz.CommentSubject) (*CommentSubject, error) {
+	objId, objType := subject.ObjId, subject.ObjType
 	sbj := CommentSubject{
 		ObjId: objId,
 		ObjType: objType,
@@ -167,18 +298,24 @@ func (c commentRepo) queryOrCreateSubject(ctx context.Context, objId uint64, obj
 		if result.Error == gorm.ErrRecordNotFound {
 			// 插入新数据
 			sql := `INSERT INTO comment_subject(id, obj_id, obj_type, member_id, count, root_count, all_count, state, 
-					created_at, updated_at) SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ? FROM DUAL 
+					title, url, attributes, created_at, updated_at) SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? FROM DUAL 
 			WHERE NOT EXISTS ( SELECT * FROM comment_subject WHERE obj_id=? AND obj_type=? );`
 			t := time.Now()
 			id := orm.NextId()
-			saveResult := c.data.db.WithContext(ctx).Exec(sql, id, objId, objType, memberId, 0, 0, 0, 0,
-				t, t, objId, objType)
+			attributes := encodeAttributes(subject.Attributes)
+			saveResult := c.data.db.WithContext(ctx).Exec(sql, id, objId, objType, subject.MemberId, 0, 0, 0, 0,
+				subject.Title, subject.Url, attributes, t, t, objId, objType)
 			if saveResult.Error != nil {
 				return nil, saveResult.Error
 			}
 			if saveResult.RowsAffected == 1 {
 				sbj.Id = id
+				sbj.MemberId = subject.MemberId
+				sbj.Title = subject.Title
+				sbj.Url = subject.Url
+				sbj.Attributes = attributes
 				sbj.CreatedAt = t
+				sbj.UpdatedAt = t
 				return &sbj, nil
 			} else {
 				// 不为1则已经有数据插入，再次查询并返回
@@ -210,44 +347,50 @@ func (c commentRepo) GetSubjectByObjBack(ctx context.Context, subject *biz.Comme
 	return result.Error
 }
 
-// GetCommentList 根据subject_id 和 subject_type 查询相关评论
-func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSubject, page, size, replyCount int) ([]*biz.Comment, error) {
-	offset := getOffset(page, size)
+// GetCommentList 根据subject_id 和 subject_type 查询根评论, 多取一条用于判断是否还有下一页
+func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSubject, sort int, cursor *biz.CommentCursor, size, replyCount int) (*biz.CommentPage, error) {
 	var indexList []*CommentIndex
-	indexListCache := false
-	// 查询缓存数据
-	cacheResult := c.data.redisDB.ZRange(ctx, fmt.Sprintf("ci:%d:%d", subject.ObjId, subject.ObjType), int64(offset), int64(offset + size))
-	if cacheResult.Err() != nil {
-		c.log.Errorf("get comment list read index list cache err: %v\n", cacheResult.Err())
-	} else {
-		err := cacheResult.ScanSlice(&indexList)
-		if err != nil || indexList == nil {
-			c.log.Errorf("get comment list scan index list cache err: %v\n", err)
-		} else {
-			indexListCache = true // read cache success
+	var scores map[uint64]float64
+	var hasMore bool
+	var err error
+	if sort != biz.CommentSortFloor {
+		var built bool
+		indexList, scores, hasMore, built, err = c.listCommentIndexByScore(ctx, subject, sort, cursor, size)
+		if err != nil {
+			return nil, err
+		}
+		if !built {
+			// 排序缓存未构建, 通知 job 构建, 本次按楼层排序返回, 后续翻页沿用楼层排序
+			if cursor != nil {
+				return nil, errs.ErrInvalidCursor
+			}
+			_ = SendProto(c.data.Queue, "comment-score-cache", subjectKey(subject.ObjId, subject.ObjType), &jobV1.CommentScoreCacheMessage{
+				ObjId: subject.ObjId,
+				ObjType: int32(subject.ObjType),
+			})
+			sort = biz.CommentSortFloor
 		}
 	}
-	// 查询主题下的根评论
-	if !indexListCache {
-		// 提交填充缓存的消息
-		cicm, _ := json.Marshal(CommentIndexCacheMessage{
-			ObjId: subject.ObjId,
-			ObjType: subject.ObjType,
-			Page: page,
-			Size: size,
-		})
-		_ = c.data.Kafka.Send("comment-index-list-cache", string(cicm))
-		// 回源数据库查询
-		indexResult := c.data.db.WithContext(ctx).
+	if sort == biz.CommentSortFloor {
+		indexList, hasMore, err = c.listCommentIndexByFloor(ctx, subject, cursor, size)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// 置顶评论只在第一页之前返回, 常规列表和缓存中都不包含置顶评论
+	if cursor == nil {
+		var pinnedList []*CommentIndex
+		result := c.data.db.WithContext(ctx).
 			Joins("Content").
 			Where("obj_id = ? AND obj_type = ? AND root = ?", subject.ObjId, subject.ObjType, 0).
-			Order("floor desc").
-			Limit(size).
-			Offset(offset).
-			Find(&indexList)
-		if indexResult.Error != nil {
-			return nil, indexResult.Error
+			Where("comment_index.state IN ?", biz.CommentVisibleStates).
+			Where("comment_index.pinned_at IS NOT NULL").
+			Order("comment_index.pinned_at desc").
+			Find(&pinnedList)
+		if result.Error != nil {
+			return nil, result.Error
 		}
+		indexList = append(pinnedList, indexList...)
 	}
 	// 取出id，用于批量查询关联的内容, 同时组装根评论结果
 	indexIds := make([]uint64, len(indexList))
@@ -256,6 +399,7 @@ func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSub
 	for i := range indexList {
 		indexIds[i] = indexList[i].Id
 		comments[i] = createComment(indexList[i])
+		comments[i].Score = scores[indexList[i].Id]
 	}
 	// 如果replyCount 不为0 则需要查询子评论
 	if replyCount > 0 {
@@ -263,11 +407,12 @@ func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSub
 		result := c.data.db.WithContext(ctx).
 			Joins("Content").
 			Where("root IN ? AND floor <= ?", indexIds, replyCount).
+			Where("comment_index.state IN ?", biz.CommentVisibleStates).
 			Order("floor asc").
 			Find(&subIndexList)
 		if result.Error != nil {
 			c.log.Errorf("get comment sub comment failed: %v", result.Error)
-			return comments, nil
+			return biz.NewCommentPage(comments, sort, hasMore), nil
 		}
 		// 查询回复对象的作者id
 		subParentId := mapset.NewSet()
@@ -282,7 +427,7 @@ func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSub
 			Where("id IN ?", subParentId.ToSlice()).
 			Find(&subParentIndex)
 		if result.Error != nil {
-			return comments, nil
+			return biz.NewCommentPage(comments, sort, hasMore), nil
 		}
 
 		parentMap := make(map[uint64]*CommentIndex)
@@ -294,23 +439,167 @@ func (c commentRepo) GetCommentList(ctx context.Context, subject *biz.CommentSub
 			comments[i].Replies = findChild(comments[i].Id, subIndexList, parentMap)
 		}
 	}
-	return comments, nil
+	return biz.NewCommentPage(comments, sort, hasMore), nil
 }
 
-// GetReplyList 查询一条评论下的回复列表
-func (c commentRepo) GetReplyList(ctx context.Context, rootId uint64, page, size int) ([]*biz.Comment, error) {
-	offset := getOffset(page, size)
+// listCommentIndexByFloor 按楼层倒序查询根评论, 优先读取 ci 缓存
+func (c commentRepo) listCommentIndexByFloor(ctx context.Context, subject *biz.CommentSubject, cursor *biz.CommentCursor, size int) ([]*CommentIndex, bool, error) {
 	var indexList []*CommentIndex
+	indexListCache := false
+	// 查询缓存数据, 缓存是从最新楼层开始的连续区间, 取不满一页时无法判断是否已到底, 需要回源
+	max := "+inf"
+	if cursor != nil {
+		max = "(" + strconv.Itoa(cursor.Floor)
+	}
+	cacheResult := c.data.redisDB.ZRevRangeByScore(ctx, fmt.Sprintf("ci:%d:%d", subject.ObjId, subject.ObjType), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: max,
+		Count: int64(size + 1),
+	})
+	if cacheResult.Err() != nil {
+		c.log.Errorf("get comment list read index list cache err: %v\n", cacheResult.Err())
+	} else {
+		err := cacheResult.ScanSlice(&indexList)
+		if err != nil {
+			c.log.Errorf("get comment list scan index list cache err: %v\n", err)
+		} else if len(indexList) > size {
+			indexListCache = true // read cache success
+		}
+	}
+	if !indexListCache {
+		// 提交填充缓存的消息
+		cicm := &jobV1.CommentIndexCacheMessage{
+			ObjId: subject.ObjId,
+			ObjType: int32(subject.ObjType),
+			Size: int32(size),
+		}
+		if cursor != nil {
+			cicm.Floor = int32(cursor.Floor)
+		}
+		_ = SendProto(c.data.Queue, "comment-index-list-cache", subjectKey(subject.ObjId, subject.ObjType), cicm)
+		// 回源数据库查询
+		indexList = nil
+		query := c.data.db.WithContext(ctx).
+			Joins("Content").
+			Where("obj_id = ? AND obj_type = ? AND root = ?", subject.ObjId, subject.ObjType, 0).
+			Where("comment_index.state IN ?", biz.CommentVisibleStates).
+			Where("comment_index.pinned_at IS NULL")
+		indexResult := afterCursor(query, cursor).
+			Limit(size + 1).
+			Find(&indexList)
+		if indexResult.Error != nil {
+			return nil, false, indexResult.Error
+		}
+	}
+	hasMore := len(indexList) > size
+	if hasMore {
+		indexList = indexList[:size]
+	}
+	return indexList, hasMore, nil
+}
+
+// listCommentIndexByScore 从 job 维护的排序缓存中按分数倒序取出评论id, 再按主键查询评论
+// 排序缓存不存在时 built 返回 false
+func (c commentRepo) listCommentIndexByScore(ctx context.Context, subject *biz.CommentSubject, sort int, cursor *biz.CommentCursor, size int) (indexList []*CommentIndex, scores map[uint64]float64, hasMore bool, built bool, err error) {
+	key := commentScoreKey(sort, subject.ObjId, subject.ObjType)
+	exists, err := c.data.redisDB.Exists(ctx, key).Result()
+	if err != nil || exists == 0 {
+		return nil, nil, false, false, err
+	}
+	max := "+inf"
+	var cursorMember string
+	if cursor != nil {
+		max = strconv.FormatFloat(cursor.Score, 'g', -1, 64)
+		cursorMember = strconv.FormatUint(cursor.Id, 10)
+	}
+	// 分数相同的成员按成员倒序排列, 跳过与游标同分且排在游标之前的成员
+	var zList []redis.Z
+	for offset := int64(0); len(zList) <= size; offset += int64(size + 1) {
+		batch, err := c.data.redisDB.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+			Min: "-inf",
+			Max: max,
+			Offset: offset,
+			Count: int64(size + 1),
+		}).Result()
+		if err != nil {
+			return nil, nil, false, true, err
+		}
+		for _, z := range batch {
+			if z.Member.(string) == emptyScoreCacheMember {
+				continue
+			}
+			if cursor != nil && z.Score == cursor.Score && z.Member.(string) >= cursorMember {
+				continue
+			}
+			if len(zList) <= size {
+				zList = append(zList, z)
+			}
+		}
+		if len(batch) <= size {
+			break
+		}
+	}
+	hasMore = len(zList) > size
+	if hasMore {
+		zList = zList[:size]
+	}
+	ids := make([]uint64, 0, len(zList))
+	scores = make(map[uint64]float64, len(zList))
+	for _, z := range zList {
+		id, err := strconv.ParseUint(z.Member.(string), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+		scores[id] = z.Score
+	}
+	if len(ids) == 0 {
+		// 缓存可能在检查之后过期, 此时不能当作没有评论
+		if exists, err = c.data.redisDB.Exists(ctx, key).Result(); err != nil || exists == 0 {
+			return nil, nil, false, false, err
+		}
+		return nil, scores, hasMore, true, nil
+	}
+	var found []*CommentIndex
 	result := c.data.db.WithContext(ctx).
+		Joins("Content").
+		Where("comment_index.id IN ?", ids).
+		Where("comment_index.state IN ?", biz.CommentVisibleStates).
+		Where("comment_index.pinned_at IS NULL").
+		Find(&found)
+	if result.Error != nil {
+		return nil, nil, false, true, result.Error
+	}
+	// 按排序缓存中的顺序返回, 已删除或不可见但还未移出缓存的评论直接跳过
+	indexMap := make(map[uint64]*CommentIndex, len(found))
+	for i := range found {
+		indexMap[found[i].Id] = found[i]
+	}
+	for _, id := range ids {
+		if ci, ok := indexMap[id]; ok {
+			indexList = append(indexList, ci)
+		}
+	}
+	return indexList, scores, hasMore, true, nil
+}
+
+// GetReplyList 按楼层倒序查询一条评论下的回复列表
+func (c commentRepo) GetReplyList(ctx context.Context, rootId uint64, cursor *biz.CommentCursor, size int) (*biz.CommentPage, error) {
+	var indexList []*CommentIndex
+	query := c.data.db.WithContext(ctx).
 		Joins("Content").
 		Where("root = ?", rootId).
-		Order("floor desc").
-		Limit(size).
-		Offset(offset).
+		Where("comment_index.state IN ?", biz.CommentVisibleStates)
+	result := afterCursor(query, cursor).
+		Limit(size + 1).
 		Find(&indexList)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	hasMore := len(indexList) > size
+	if hasMore {
+		indexList = indexList[:size]
+	}
 
 	// 查询parent
 	parentIds := make([]uint64, len(indexList))
@@ -337,22 +626,238 @@ func (c commentRepo) GetReplyList(ctx context.Context, rootId uint64, page, size
 			ret[i] = createComment(indexList[i])
 		}
 	}
-	return ret, nil
+	return biz.NewCommentPage(ret, biz.CommentSortFloor, hasMore), nil
+}
+
+// ListCommentsByMember 游标只使用评论id, 由 idx_comment_index_member 索引支持
+func (c commentRepo) ListCommentsByMember(ctx context.Context, memberId uint64, cursor *biz.CommentCursor, size int) (*biz.CommentPage, error) {
+	var indexList []*CommentIndex
+	query := c.data.db.WithContext(ctx).
+		Joins("Content").
+		Where("comment_index.member_id = ?", memberId).
+		Where("comment_index.state IN ?", biz.CommentAuthorVisibleStates)
+	if cursor != nil {
+		query = query.Where("comment_index.id < ?", cursor.Id)
+	}
+	result := query.Order("comment_index.id desc").
+		Limit(size + 1).
+		Find(&indexList)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	hasMore := len(indexList) > size
+	if hasMore {
+		indexList = indexList[:size]
+	}
+	ret := make([]*biz.Comment, len(indexList))
+	for i := range indexList {
+		ret[i] = createComment(indexList[i])
+	}
+	return biz.NewCommentPage(ret, biz.CommentSortFloor, hasMore), nil
+}
+
+// afterCursor 按楼层倒序排列并从游标之后开始查询
+func afterCursor(query *gorm.DB, cursor *biz.CommentCursor) *gorm.DB {
+	if cursor != nil {
+		query = query.Where("(floor < ? OR (floor = ? AND comment_index.id < ?))", cursor.Floor, cursor.Floor, cursor.Id)
+	}
+	return query.Order("floor desc").Order("comment_index.id desc")
+}
+
+// subjectKey 主题消息的 key, 同一主题的消息按顺序消费
+func subjectKey(objId uint64, objType int) string {
+	return fmt.Sprintf("%d:%d", objId, objType)
 }
 
 func (c commentRepo) SaveComment(_ context.Context, subject *biz.CommentSubject, comment *biz.Comment) error {
-	comment.Id = orm.NextId()
-	paramByte, _ := json.Marshal(SaveCommentMessage{
-		Subject: *subject,
-		Comment: *comment,
+	// 带请求id的评论已预先分配id
+	if comment.Id == 0 {
+		comment.Id = orm.NextId()
+	}
+	mentions := make([]*jobV1.SaveCommentMessage_Mention, len(comment.Mentions))
+	for i, mention := range comment.Mentions {
+		mentions[i] = &jobV1.SaveCommentMessage_Mention{
+			MemberId: mention.MemberId,
+			Start: int32(mention.Start),
+			End: int32(mention.End),
+		}
+	}
+	// 同一主题的评论写入同一分区, 由 job 按顺序分配楼层
+	return SendProto(c.data.Queue, "comment_chan", subjectKey(subject.ObjId, subject.ObjType), &jobV1.SaveCommentMessage{
+		Subject: &jobV1.SaveCommentMessage_Subject{
+			ObjId: subject.ObjId,
+			ObjType: int32(subject.ObjType),
+			MemberId: subject.MemberId,
+		},
+		Comment: &jobV1.SaveCommentMessage_Comment{
+			Id: comment.Id,
+			MemberId: comment.MemberId,
+			Root: comment.Root,
+			Parent: comment.Parent,
+			ParentMemberId: comment.ParentMemberId,
+			State: int32(comment.State),
+			AtMemberIds: comment.AtMemberIds,
+			Ip: comment.Ip,
+			Platform: int32(comment.Platform),
+			Device: comment.Device,
+			Message: comment.Message,
+			Meta: comment.Meta,
+			Mentions: mentions,
+			IdempotencyKey: comment.IdempotencyKey,
+			IdempotencyFingerprint: comment.IdempotencyFingerprint,
+		},
 	})
-	err := c.data.Kafka.Send("comment_chan", string(paramByte))
+}
+
+// idempotencyKey 成员的请求id对应的发表请求, 值为 json 编码的 biz.IdempotencyRecord
+func idempotencyKey(memberId uint64, key string) string {
+	return fmt.Sprintf("cik:%d:%s", memberId, key)
+}
+
+func (c commentRepo) ClaimIdempotencyKey(ctx context.Context, memberId uint64, key string, fingerprint string, ttl time.Duration) (*biz.IdempotencyRecord, bool, error) {
+	record := &biz.IdempotencyRecord{CommentId: orm.NextId(), Fingerprint: fingerprint}
+	value, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, err
+	}
+	claimed, err := c.data.redisDB.SetNX(ctx, idempotencyKey(memberId, key), value, ttl).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if claimed {
+		return record, true, nil
+	}
+	value, err = c.data.redisDB.Get(ctx, idempotencyKey(memberId, key)).Bytes()
+	if err != nil {
+		return nil, false, err
+	}
+	// 旧版本只记录了已发表的评论id
+	if id, err := strconv.ParseUint(string(value), 10, 64); err == nil {
+		return &biz.IdempotencyRecord{CommentId: id, Done: true}, false, nil
+	}
+	saved := &biz.IdempotencyRecord{}
+	if err = json.Unmarshal(value, saved); err != nil {
+		return nil, false, err
+	}
+	return saved, false, nil
+}
+
+func (c commentRepo) SaveIdempotencyKey(ctx context.Context, memberId uint64, key string, record *biz.IdempotencyRecord, ttl time.Duration) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return c.data.redisDB.Set(ctx, idempotencyKey(memberId, key), value, ttl).Err()
+}
+
+func (c commentRepo) GetSavedIdempotencyKey(ctx context.Context, memberId uint64, key string) (*biz.IdempotencyRecord, error) {
+	var saved []CommentIdempotency
+	result := c.data.db.WithContext(ctx).
+		Where("member_id = ? AND idempotency_key = ?", memberId, key).
+		Limit(1).
+		Find(&saved)
+	if result.Error != nil || len(saved) == 0 {
+		return nil, result.Error
+	}
+	return &biz.IdempotencyRecord{CommentId: saved[0].CommentId, Fingerprint: saved[0].Fingerprint, Done: true}, nil
+}
+
+func (c commentRepo) ReleaseIdempotencyKey(ctx context.Context, memberId uint64, key string) error {
+	return c.data.redisDB.Del(ctx, idempotencyKey(memberId, key)).Err()
+}
+
+// queuedCommentKey 成员在主题下尚未保存的评论, field 为评论id
+func queuedCommentKey(memberId uint64, objId uint64, objType int) string {
+	return fmt.Sprintf("cq:%d:%d:%d", memberId, objId, objType)
+}
+
+// queuedCommentIdKey 按id查询尚未保存的评论
+func queuedCommentIdKey(id uint64) string {
+	return fmt.Sprintf("cq:%d", id)
+}
+
+func (c commentRepo) SaveQueuedComment(ctx context.Context, comment *biz.Comment, ttl time.Duration) error {
+	value, err := json.Marshal(comment)
+	if err != nil {
+		return err
+	}
+	key := queuedCommentKey(comment.MemberId, comment.ObjId, comment.ObjType)
+	pipe := c.data.redisDB.TxPipeline()
+	pipe.HSet(ctx, key, strconv.FormatUint(comment.Id, 10), value)
+	pipe.Expire(ctx, key, ttl)
+	pipe.Set(ctx, queuedCommentIdKey(comment.Id), value, ttl)
+	_, err = pipe.Exec(ctx)
 	return err
 }
 
+func (c commentRepo) ListQueuedComment(ctx context.Context, memberId uint64, objId uint64, objType int) ([]*biz.Comment, error) {
+	key := queuedCommentKey(memberId, objId, objType)
+	values, err := c.data.redisDB.HGetAll(ctx, key).Result()
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+	queued := make(map[uint64]*biz.Comment, len(values))
+	ids := make([]uint64, 0, len(values))
+	for _, value := range values {
+		var comment biz.Comment
+		if err = json.Unmarshal([]byte(value), &comment); err != nil {
+			return nil, err
+		}
+		queued[comment.Id] = &comment
+		ids = append(ids, comment.Id)
+	}
+	persisted, err := c.listPersistedIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	// 已保存的评论从列表中正常返回, 清理掉
+	if len(persisted) > 0 {
+		fields := make([]string, len(persisted))
+		for i, id := range persisted {
+			fields[i] = strconv.FormatUint(id, 10)
+			delete(queued, id)
+		}
+		c.data.redisDB.HDel(ctx, key, fields...)
+	}
+	comments := make([]*biz.Comment, 0, len(queued))
+	for _, comment := range queued {
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+func (c commentRepo) GetQueuedComment(ctx context.Context, id uint64) (*biz.Comment, error) {
+	value, err := c.data.redisDB.Get(ctx, queuedCommentIdKey(id)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// 保存后又被删除的评论不再返回
+	persisted, err := c.listPersistedIds(ctx, []uint64{id})
+	if err != nil || len(persisted) > 0 {
+		return nil, err
+	}
+	var comment biz.Comment
+	if err = json.Unmarshal(value, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// listPersistedIds 查询已保存的评论id, 包含已删除的评论
+func (c commentRepo) listPersistedIds(ctx context.Context, ids []uint64) ([]uint64, error) {
+	var persisted []uint64
+	result := c.data.db.WithContext(ctx).Unscoped().Model(&CommentIndex{}).
+		Where("id IN ?", ids).
+		Pluck("id", &persisted)
+	return persisted, result.Error
+}
+
 func (c commentRepo) SaveCommentBack(ctx context.Context, subject *biz.CommentSubject, comment *biz.Comment) error {
 	// 查询subject
-	savedSbj, err := c.queryOrCreateSubject(ctx, subject.ObjId, subject.ObjType, subject.MemberId)
+	savedSbj, err := c.queryOrCreateSubject(ctx, subject)
 	if err != nil {
 		return err
 	}
@@ -364,6 +869,7 @@ func (c commentRepo) SaveCommentBack(ctx context.Context, subject *biz.CommentSu
 		Device:      comment.Device,
 		Message:     comment.Message,
 		Meta:        comment.Meta,
+		Mentions:    encodeMentions(comment.Mentions),
 	}
 	content.Id = orm.NextId()
 	r := c.data.db.WithContext(ctx).
@@ -433,88 +939,563 @@ func (c commentRepo) GetCommentIndex(ctx context.Context, id uint64) (comment *b
 	result := c.data.db.WithContext(ctx).Joins("Content").
 		First(&ci, id)
 	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, errs.ErrNotFound
+		}
 		return nil, result.Error
 	}
 	return createComment(&ci), nil
 }
 
-func (c commentRepo) UpdateLikeNum(ctx context.Context, comment *biz.Comment) error {
-	if comment.Like == 0 {
-		return nil
-	} else if comment.Like > 1 {
-		comment.Like = 1
-	} else if comment.Like < -1 {
-		comment.Like = -1
-	}
+// DeleteComment 软删除评论, 同时扣减主题与根评论的计数, 缓存由 job 消费删除事件后刷新
+func (c commentRepo) DeleteComment(ctx context.Context, id uint64) error {
 	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 查询是否有记录
-		var likedRecord CommentLike
-		result := tx.
-			Where("member_id = ? AND comment_id = ?", comment.MemberId, comment.Id).
-			First(&likedRecord)
-		if result.Error != nil && result.Error != gorm.ErrRecordNotFound {
+		var ci CommentIndex
+		result := tx.First(&ci, id)
+		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return errs.ErrNotFound
+			}
 			return result.Error
-		} else if result.Error == gorm.ErrRecordNotFound && comment.Like == -1 {
-			return nil // 不存在记录，则不能减一
-		} else if result.Error == nil && comment.Like == 1 {
-			return nil // 存在记录时，不能加1
 		}
-		// 更新like数量
+		// 删除index, 影响行数为0说明已被并发删除
+		result = tx.Delete(&ci)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrNotFound
+		}
+		result = tx.Delete(&CommentContent{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		// 列表缓存与排序分数由 job 消费删除事件后刷新
+		err := saveCommentEvent(tx, ci.ObjId, ci.ObjType, &jobV1.CommentEvent{
+			Event: &jobV1.CommentEvent_Deleted{Deleted: &jobV1.CommentDeleted{
+				CommentId: ci.Id,
+				ObjId: ci.ObjId,
+				ObjType: int32(ci.ObjType),
+				Root: ci.Root,
+			}},
+		})
+		if err != nil {
+			return err
+		}
+		if ci.Root == 0 {
+			if err = deleteReplies(tx, &ci); err != nil {
+				return err
+			}
+		}
+		// 不可见的评论没有计入计数
+		if !biz.IsCommentVisible(ci.State) {
+			return nil
+		}
+		return updateCommentCount(tx, &ci, -1)
+	})
+}
+
+// UpdateCommentContent 保存修订记录后更新评论内容, 状态变化时调整计数
+// 写入编辑事件, 由 job 重新过滤敏感词并刷新缓存与排序分数
+func (c commentRepo) UpdateCommentContent(ctx context.Context, comment *biz.Comment, state int8) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var ci CommentIndex
-		ci.Id = comment.Id
-		result = tx.Model(&ci).Updates(orm.UpdateFields{
-			"like": gorm.Expr("`like` + ?", comment.Like),
+		// 先锁定索引再锁定内容, 与删除评论的加锁顺序一致
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&ci, comment.Id)
+		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return errs.ErrNotFound
+			}
+			return result.Error
+		}
+		// 状态已被并发修改(如审核), 由调用方重新判断
+		if ci.State != comment.State {
+			return errs.ErrInvalidState
+		}
+		var content CommentContent
+		result = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&content, comment.Id)
+		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return errs.ErrNotFound
+			}
+			return result.Error
+		}
+		revision := CommentContentRevision{
+			CommentId: content.Id,
+			Message:   content.Message,
+			Meta:      content.Meta,
+		}
+		revision.Id = orm.NextId()
+		result = tx.Create(&revision)
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Model(&content).Updates(orm.UpdateFields{
+			"message": comment.Message,
+			"meta": comment.Meta,
+			"at_member_ids": comment.AtMemberIds,
+			"mentions": encodeMentions(comment.Mentions),
+			"edited_at": time.Now(),
 		})
 		if result.Error != nil {
 			return result.Error
 		}
-		// 插入或删除记录
-		if comment.Like == 1 {
-			like := CommentLike{
-				CommentId: comment.Id,
-				MemberId: comment.MemberId,
+		if state != ci.State {
+			result = tx.Model(&ci).Update("state", state)
+			if result.Error != nil {
+				return result.Error
 			}
-			result = tx.Create(&like)
-		} else {
-			result = tx.Where("member_id = ? AND comment_id = ?", comment.MemberId, comment.Id).
-				Unscoped().Delete(CommentLike{})
+			delta := 0
+			if biz.IsCommentVisible(state) {
+				delta++
+			}
+			if biz.IsCommentVisible(comment.State) {
+				delta--
+			}
+			if delta != 0 {
+				if err := updateCommentCount(tx, &ci, delta); err != nil {
+					return err
+				}
+			}
+		}
+		return saveCommentEvent(tx, ci.ObjId, ci.ObjType, &jobV1.CommentEvent{
+			Event: &jobV1.CommentEvent_Edited{Edited: &jobV1.CommentEdited{
+				CommentId: ci.Id,
+				ObjId: ci.ObjId,
+				ObjType: int32(ci.ObjType),
+				Root: ci.Root,
+			}},
+		})
+	})
+}
+
+func (c commentRepo) GetSubjectById(ctx context.Context, id uint64) (*biz.CommentSubject, error) {
+	var sbj CommentSubject
+	result := c.data.db.WithContext(ctx).First(&sbj, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, errs.ErrNotFound
+		}
+		return nil, result.Error
+	}
+	subject := &biz.CommentSubject{}
+	copyCommentSubject(subject, &sbj)
+	return subject, nil
+}
+
+// UpdateCommentPinned 置顶或取消置顶评论, 写入置顶事件, 由 job 将置顶的评论移出列表缓存与排序缓存, 取消置顶后写回
+// 置顶时锁定主题行后统计置顶数量, 并发置顶不会超过上限; 先锁定索引再锁定主题, 与调整计数的加锁顺序一致
+func (c commentRepo) UpdateCommentPinned(ctx context.Context, comment *biz.Comment, pinned bool, maxPinned int) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ci CommentIndex
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&ci, comment.Id)
+		if result.Error != nil {
+			if result.Error == gorm.ErrRecordNotFound {
+				return errs.ErrNotFound
+			}
+			return result.Error
+		}
+		if (ci.PinnedAt != nil) == pinned {
+			return nil
 		}
+		var pinnedAt *time.Time
+		if pinned {
+			if ci.Root != 0 || !biz.IsCommentVisible(ci.State) {
+				return errs.ErrInvalidState
+			}
+			var sbj CommentSubject
+			result = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&sbj, ci.SubjectId)
+			if result.Error != nil {
+				return result.Error
+			}
+			if maxPinned > 0 {
+				var count int64
+				result = tx.Model(&CommentIndex{}).
+					Where("subject_id = ? AND pinned_at IS NOT NULL", ci.SubjectId).
+					Where("state IN ?", biz.CommentVisibleStates).
+					Count(&count)
+				if result.Error != nil {
+					return result.Error
+				}
+				if int(count) >= maxPinned {
+					return errs.ErrPinLimitExceeded
+				}
+			}
+			t := time.Now()
+			pinnedAt = &t
+		}
+		if err := tx.Model(&ci).Update("pinned_at", pinnedAt).Error; err != nil {
+			return err
+		}
+		return saveCommentEvent(tx, ci.ObjId, ci.ObjType, &jobV1.CommentEvent{
+			Event: &jobV1.CommentEvent_Pinned{Pinned: &jobV1.CommentPinned{
+				CommentId: ci.Id,
+				ObjId: ci.ObjId,
+				ObjType: int32(ci.ObjType),
+				Pinned: pinned,
+			}},
+		})
+	})
+}
+
+// UpdateSubjectState 更新主题状态并记录变更时间
+func (c commentRepo) UpdateSubjectState(ctx context.Context, subject *biz.CommentSubject, state int8) error {
+	t := time.Now()
+	sbj := CommentSubject{}
+	sbj.Id = subject.Id
+	result := c.data.db.WithContext(ctx).Model(&sbj).Updates(orm.UpdateFields{
+		"state": state,
+		"state_changed_at": t,
+	})
+	if result.Error != nil {
 		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+	subject.State = state
+	subject.StateChangedAt = t
+	return nil
+}
+
+// ListPendingComment 按创建顺序查询待审核的评论
+func (c commentRepo) ListPendingComment(ctx context.Context, objType int, page, size int) ([]*biz.Comment, error) {
+	var indexList []*CommentIndex
+	query := c.data.db.WithContext(ctx).
+		Joins("Content").
+		Where("comment_index.state = ?", biz.CommentStatePending)
+	if objType != 0 {
+		query = query.Where("obj_type = ?", objType)
+	}
+	result := query.
+		Order("comment_index.id asc").
+		Limit(size).
+		Offset(getOffset(page, size)).
+		Find(&indexList)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	comments := make([]*biz.Comment, len(indexList))
+	for i := range indexList {
+		comments[i] = createComment(indexList[i])
+	}
+	c.fillFilterWords(ctx, comments)
+	return comments, nil
+}
+
+// fillFilterWords 填充评论命中的敏感词, 查询失败时不影响列表
+func (c commentRepo) fillFilterWords(ctx context.Context, comments []*biz.Comment) {
+	if len(comments) == 0 {
+		return
+	}
+	ids := make([]uint64, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.Id
+	}
+	var hitList []*CommentFilterHit
+	if err := c.data.db.WithContext(ctx).Where("comment_id IN ?", ids).Find(&hitList).Error; err != nil {
+		c.log.Errorf("list comment filter hit err: %v", err)
+		return
+	}
+	wordMap := make(map[uint64][]string, len(hitList))
+	for _, item := range hitList {
+		var hits []struct{
+			Word string `json:"word"`
+		}
+		if err := json.Unmarshal([]byte(item.Hits), &hits); err != nil {
+			c.log.Errorf("unmarshal comment %d filter hit err: %v", item.CommentId, err)
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, hit := range hits {
+			if !seen[hit.Word] {
+				seen[hit.Word] = true
+				wordMap[item.CommentId] = append(wordMap[item.CommentId], hit.Word)
+			}
+		}
+	}
+	for _, comment := range comments {
+		comment.FilterWords = wordMap[comment.Id]
+	}
+}
+
+// UpdateCommentState 更新评论状态, 可见性发生变化时同步调整计数, 并写入状态变化事件由 job 刷新缓存与排序分数
+// 待审核或审核未通过的评论发布时写入创建事件, 由 job 生成回复与 @ 通知
+func (c commentRepo) UpdateCommentState(ctx context.Context, comment *biz.Comment, state int8) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ci CommentIndex
+		// 以旧状态为条件更新, 防止并发审核重复调整计数
+		result := tx.Model(&CommentIndex{}).
+			Where("id = ? AND state = ?", comment.Id, comment.State).
+			Update("state", state)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrInvalidState
+		}
+		result = tx.First(&ci, comment.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+		delta := 0
+		if biz.IsCommentVisible(state) {
+			delta++
+		}
+		if biz.IsCommentVisible(comment.State) {
+			delta--
+		}
+		if delta != 0 {
+			if err := updateCommentCount(tx, &ci, delta); err != nil {
+				return err
+			}
+		}
+		if state == biz.CommentStatePublished &&
+			(comment.State == biz.CommentStatePending || comment.State == biz.CommentStateRejected) {
+			return saveCommentPublished(tx, &ci)
+		}
+		return saveCommentEvent(tx, ci.ObjId, ci.ObjType, &jobV1.CommentEvent{
+			Event: &jobV1.CommentEvent_StateChanged{StateChanged: &jobV1.CommentStateChanged{
+				CommentId: ci.Id,
+				ObjId: ci.ObjId,
+				ObjType: int32(ci.ObjType),
+				Root: ci.Root,
+				OldState: int32(comment.State),
+				State: int32(state),
+			}},
+		})
 	})
 }
 
-func (c commentRepo) UpdateHateNum(ctx context.Context, comment *biz.Comment) error {
-	result := c.data.db.WithContext(ctx).Updates(orm.UpdateFields{
-		"hate": gorm.Expr("hate + ?", comment.Hate),
+// saveCommentPublished 审核通过的评论写入创建事件, 回复对象的作者由 job 查询
+func saveCommentPublished(tx *gorm.DB, ci *CommentIndex) error {
+	var content CommentContent
+	result := tx.First(&content, ci.Id)
+	if result.Error != nil {
+		return result.Error
+	}
+	var mentions []biz.Mention
+	if content.Mentions != "" {
+		_ = json.Unmarshal([]byte(content.Mentions), &mentions)
+	}
+	mentionMemberIds := make([]uint64, len(mentions))
+	for i, mention := range mentions {
+		mentionMemberIds[i] = mention.MemberId
+	}
+	return saveCommentEvent(tx, ci.ObjId, ci.ObjType, &jobV1.CommentEvent{
+		Event: &jobV1.CommentEvent_Created{Created: &jobV1.CommentCreated{
+			CommentId: ci.Id,
+			ObjId: ci.ObjId,
+			ObjType: int32(ci.ObjType),
+			MemberId: ci.MemberId,
+			Root: ci.Root,
+			Parent: ci.Parent,
+			State: int32(ci.State),
+			MentionMemberIds: mentionMemberIds,
+		}},
 	})
+}
+
+// emptyScoreCacheMember job 为没有评论的主题写入的占位成员
+const emptyScoreCacheMember = "0"
+
+// commentScoreKey 主题的排序缓存, ch 按热度, ct 按点赞数, 成员为根评论id
+func commentScoreKey(sort int, objId uint64, objType int) string {
+	if sort == biz.CommentSortTop {
+		return fmt.Sprintf("ct:%d:%d", objId, objType)
+	}
+	return fmt.Sprintf("ch:%d:%d", objId, objType)
+}
+
+// updateCommentCount 调整评论所在主题的计数, 回复还需调整根评论的回复数
+func updateCommentCount(tx *gorm.DB, ci *CommentIndex, delta int) error {
+	updateFields := orm.UpdateFields{
+		"count": gorm.Expr("count + ?", delta),
+		"all_count": gorm.Expr("all_count + ?", delta),
+	}
+	if ci.Root == 0 {
+		updateFields["root_count"] = gorm.Expr("root_count + ?", delta)
+	}
+	sbj := CommentSubject{}
+	sbj.Id = ci.SubjectId
+	result := tx.Model(&sbj).Updates(updateFields)
+	if result.Error != nil {
+		return result.Error
+	}
+	if ci.Root != 0 {
+		rootIndex := CommentIndex{}
+		rootIndex.Id = ci.Root
+		result = tx.Model(&rootIndex).Updates(orm.UpdateFields{
+			"count": gorm.Expr("count + ?", delta),
+			"root_count": gorm.Expr("root_count + ?", delta),
+		})
+	}
 	return result.Error
 }
 
-func (c commentRepo) GetLikedComment(ctx context.Context, memberId uint64, commentIds []uint64) ([]*biz.LikeItem, error) {
-	var likeList []*CommentLike
-	result := c.data.db.WithContext(ctx).
-		Where("member_id = ? AND comment_id IN ?", memberId, commentIds).
-		Find(&likeList)
+// deleteReplies 删除根评论的所有回复, 并从主题计数中扣减其中可见的回复
+func deleteReplies(tx *gorm.DB, root *CommentIndex) error {
+	var visible int64
+	result := tx.Model(&CommentIndex{}).
+		Where("root = ? AND state IN ?", root.Id, biz.CommentVisibleStates).
+		Count(&visible)
 	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			return make([]*biz.LikeItem, 0), nil
+		return result.Error
+	}
+	var ids []uint64
+	if result = tx.Model(&CommentIndex{}).Where("root = ?", root.Id).Pluck("id", &ids); result.Error != nil {
+		return result.Error
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if result = tx.Where("id IN ?", ids).Delete(&CommentIndex{}); result.Error != nil {
+		return result.Error
+	}
+	if result = tx.Where("id IN ?", ids).Delete(&CommentContent{}); result.Error != nil {
+		return result.Error
+	}
+	if visible == 0 {
+		return nil
+	}
+	sbj := CommentSubject{}
+	sbj.Id = root.SubjectId
+	return tx.Model(&sbj).Updates(orm.UpdateFields{
+		"count": gorm.Expr("count - ?", visible),
+		"all_count": gorm.Expr("all_count - ?", visible),
+	}).Error
+}
+
+// UpdateReaction 更新成员对评论的表态, reaction 为空时取消表态
+func (c commentRepo) UpdateReaction(ctx context.Context, id uint64, memberId uint64, reaction string) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var record CommentReaction
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("member_id = ? AND comment_id = ?", memberId, id).
+			First(&record)
+		if result.Error != nil && result.Error != gorm.ErrRecordNotFound {
+			return result.Error
 		}
-		return nil, result.Error
+		exists := result.Error == nil
+		if (exists && record.Reaction == reaction) || (!exists && reaction == "") {
+			return nil
+		}
+		// 每个成员只保留一个表态, 先撤销旧的表态
+		if exists {
+			if err := updateReactionCount(tx, id, record.Reaction, -1); err != nil {
+				return err
+			}
+			if reaction == "" {
+				result = tx.Where("member_id = ? AND comment_id = ?", memberId, id).
+					Delete(&CommentReaction{})
+			} else {
+				result = tx.Model(&CommentReaction{}).
+					Where("member_id = ? AND comment_id = ?", memberId, id).
+					Update("reaction", reaction)
+			}
+		} else {
+			result = tx.Create(&CommentReaction{
+				MemberId: memberId,
+				CommentId: id,
+				Reaction: reaction,
+			})
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		// 排序分数与表态通知由 job 消费表态事件后处理
+		var ci CommentIndex
+		if result = tx.Select("id", "obj_id", "obj_type").First(&ci, id); result.Error != nil {
+			return result.Error
+		}
+		err := saveCommentEvent(tx, ci.ObjId, ci.ObjType, &jobV1.CommentEvent{
+			Event: &jobV1.CommentEvent_Liked{Liked: &jobV1.CommentLiked{
+				CommentId: id,
+				ObjId: ci.ObjId,
+				ObjType: int32(ci.ObjType),
+				MemberId: memberId,
+				Reaction: reaction,
+			}},
+		})
+		if err != nil {
+			return err
+		}
+		if reaction == "" {
+			return nil
+		}
+		return updateReactionCount(tx, id, reaction, 1)
+	})
+}
+
+// updateReactionCount 调整评论某个表态的计数, like 与 dislike 同时调整评论的点赞与点踩数
+func updateReactionCount(tx *gorm.DB, id uint64, reaction string, delta int) error {
+	result := tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(orm.UpdateFields{"count": gorm.Expr("count + ?", delta)}),
+	}).Create(&CommentReactionCount{
+		CommentId: id,
+		Reaction: reaction,
+		Count: delta,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	var column string
+	switch reaction {
+	case biz.ReactionLike:
+		column = "like"
+	case biz.ReactionDislike:
+		column = "hate"
+	default:
+		return nil
 	}
+	ci := CommentIndex{}
+	ci.Id = id
+	return tx.Model(&ci).Updates(orm.UpdateFields{
+		column: gorm.Expr("`" + column + "` + ?", delta),
+	}).Error
+}
 
-	likeItems := make([]*biz.LikeItem, len(likeList))
-	for i := range likeList {
-		likeItems[i] = &biz.LikeItem{
-			CommentId: likeList[i].CommentId,
-			Like:      true,
+// ListReactionCount 批量查询评论各表态的计数
+func (c commentRepo) ListReactionCount(ctx context.Context, commentIds []uint64) (map[uint64]map[string]int, error) {
+	var countList []*CommentReactionCount
+	result := c.data.db.WithContext(ctx).
+		Where("comment_id IN ? AND count > 0", commentIds).
+		Find(&countList)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	counts := make(map[uint64]map[string]int)
+	for _, item := range countList {
+		if counts[item.CommentId] == nil {
+			counts[item.CommentId] = make(map[string]int)
 		}
+		counts[item.CommentId][item.Reaction] = item.Count
+	}
+	return counts, nil
+}
+
+// ListMemberReaction 批量查询成员对评论的表态
+func (c commentRepo) ListMemberReaction(ctx context.Context, memberId uint64, commentIds []uint64) (map[uint64]string, error) {
+	var reactionList []*CommentReaction
+	result := c.data.db.WithContext(ctx).
+		Where("member_id = ? AND comment_id IN ?", memberId, commentIds).
+		Find(&reactionList)
+	if result.Error != nil {
+		return nil, result.Error
 	}
-	return likeItems, nil
+	reactions := make(map[uint64]string, len(reactionList))
+	for _, item := range reactionList {
+		reactions[item.CommentId] = item.Reaction
+	}
+	return reactions, nil
 }
 
 func createComment(ci *CommentIndex) *biz.Comment {
-	return &biz.Comment{
+	comment := &biz.Comment{
 		Id:          ci.Id,
+		SubjectId:   ci.SubjectId,
+		ObjId:       ci.ObjId,
+		ObjType:     ci.ObjType,
 		MemberId:    ci.MemberId,
 		Root:        ci.Root,
 		Parent:      ci.Parent,
@@ -532,8 +1513,25 @@ func createComment(ci *CommentIndex) *biz.Comment {
 		Meta:        ci.Content.Meta,
 		CreatedAt:   ci.Content.CreatedAt,
 		UpdatedAt:   ci.Content.UpdatedAt,
+		Pinned:      ci.PinnedAt != nil,
 		Replies:     make([]*biz.Comment, 0),
 	}
+	if ci.Content.EditedAt != nil {
+		comment.Edited = true
+		comment.EditedAt = *ci.Content.EditedAt
+	}
+	if ci.Content.Mentions != "" {
+		_ = json.Unmarshal([]byte(ci.Content.Mentions), &comment.Mentions)
+	}
+	return comment
+}
+
+func encodeMentions(mentions []biz.Mention) string {
+	if len(mentions) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(mentions)
+	return string(b)
 }
 
 func createCommentWithParentMember(ci *CommentIndex, parentMemberId uint64) *biz.Comment {
@@ -566,6 +1564,23 @@ func copyCommentSubject(subject *biz.CommentSubject, sbj *CommentSubject) {
 	subject.AllCount = sbj.AllCount
 	subject.RootCount = sbj.RootCount
 	subject.State = sbj.State
+	if sbj.StateChangedAt != nil {
+		subject.StateChangedAt = *sbj.StateChangedAt
+	}
+	subject.Title = sbj.Title
+	subject.Url = sbj.Url
+	subject.Attributes = nil
+	if sbj.Attributes != "" {
+		_ = json.Unmarshal([]byte(sbj.Attributes), &subject.Attributes)
+	}
 	subject.CreatedAt = sbj.CreatedAt
 	subject.UpdatedAt = sbj.UpdatedAt
+}
+
+func encodeAttributes(attributes map[string]string) string {
+	if len(attributes) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(attributes)
+	return string(b)
 }
\ No newline at end of file
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"github.com/Shopify/sarama"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSubjectKey(t *testing.T) {
+	tests := []struct {
+		objId uint64
+		objType int
+		want string
+	}{
+		{1, 1, "1:1"},
+		{12, 3, "12:3"},
+		{1, 23, "1:23"},
+		{18446744073709551615, 2147483647, "18446744073709551615:2147483647"},
+	}
+	seen := make(map[string]bool)
+	for _, tt := range tests {
+		got := subjectKey(tt.objId, tt.objType)
+		if got != tt.want {
+			t.Errorf("subjectKey(%d, %d) = %q, want %q", tt.objId, tt.objType, got, tt.want)
+		}
+		if seen[got] {
+			t.Errorf("subjectKey(%d, %d) = %q collides", tt.objId, tt.objType, got)
+		}
+		seen[got] = true
+	}
+}
+
+// TestSubjectKeyPartition 同一主题的消息无论写入哪个主题都落在相同编号的分区, 保证按主题有序
+func TestSubjectKeyPartition(t *testing.T) {
+	const partitions = 16
+	topics := []string{"comment_chan", "comment-index-list-cache", "comment-score-cache", "comment-event"}
+	subjects := []struct {
+		objId uint64
+		objType int
+	}{
+		{1, 1}, {2, 1}, {1, 2}, {100, 3}, {123456789, 7},
+	}
+	for _, subject := range subjects {
+		key := subjectKey(subject.objId, subject.objType)
+		want := int32(-1)
+		for _, topic := range topics {
+			for i := 0; i < 3; i++ {
+				partition, err := sarama.NewHashPartitioner(topic).Partition(&sarama.ProducerMessage{
+					Topic: topic,
+					Key: sarama.StringEncoder(key),
+				}, partitions)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want == -1 {
+					want = partition
+				}
+				if partition != want {
+					t.Errorf("key %s of topic %s in partition %d, want %d", key, topic, partition, want)
+				}
+			}
+		}
+	}
+}
+
+// job 创建主题后按相同的键删除缓存, 修改键时两边需要一起修改
+func TestSubjectMissKeys(t *testing.T) {
+	tests := []struct {
+		objId uint64
+		objType int
+		miss, version string
+	}{
+		{1, 1, "csm:1:1", "csv:1:1"},
+		{12, 3, "csm:12:3", "csv:12:3"},
+	}
+	for _, tt := range tests {
+		if got := subjectMissKey(tt.objId, tt.objType); got != tt.miss {
+			t.Errorf("subjectMissKey(%d, %d) = %q, want %q", tt.objId, tt.objType, got, tt.miss)
+		}
+		if got := subjectVersionKey(tt.objId, tt.objType); got != tt.version {
+			t.Errorf("subjectVersionKey(%d, %d) = %q, want %q", tt.objId, tt.objType, got, tt.version)
+		}
+	}
+}
+
+// 同一字段属于多个联合索引时, 各索引的字段顺序由 priority 决定
+func TestCommentIndexIndexes(t *testing.T) {
+	s, err := schema.Parse(&CommentIndex{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		columns []string
+	}{
+		{"idx_comment_index_obj", []string{"obj_id", "obj_type", "root", "floor"}},
+		{"idx_comment_index_subject", []string{"subject_id", "root", "floor"}},
+	}
+	for _, tt := range tests {
+		index := s.LookIndex(tt.name)
+		if index == nil {
+			t.Errorf("%s not found", tt.name)
+			continue
+		}
+		var columns []string
+		for _, field := range index.Fields {
+			columns = append(columns, field.DBName)
+		}
+		if !reflect.DeepEqual(columns, tt.columns) {
+			t.Errorf("%s columns = %v, want %v", tt.name, columns, tt.columns)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"base-service/app/comment/service/internal/biz"
 	"base-service/app/comment/service/internal/conf"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
@@ -11,13 +12,13 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewCommentRepo)
+var ProviderSet = wire.NewSet(NewData, NewCommentRepo, NewNotificationRepo)
 
 // Data .
 type Data struct {
 	db *gorm.DB
 	redisDB *redis.Client
-	Kafka *Kafka
+	Queue Queue
 }
 
 // NewData .
@@ -28,6 +29,10 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		logg.Errorf("failed opening connection to mysql: %v", err)
 		return nil, nil, err
 	}
+	if err = migrate(db); err != nil {
+		logg.Errorf("failed migrating comment tables: %v", err)
+		return nil, nil, err
+	}
 	// redis
 	r := redis.NewClient(&redis.Options{
 		Addr: c.Redis.Addr,
@@ -39,19 +44,134 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	// kafka
-	kafka, err := NewKafka(c.Kafka.Addr, logger)
+	// 消息队列
+	queue, err := NewQueue(c.Kafka, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	d := &Data{db: db, redisDB: r, Kafka: kafka}
+	d := &Data{db: db, redisDB: r, Queue: queue}
 	cleanup := func() {
-		kafka.close()
+		queue.close()
 		logg.Infof("comment service data clean up")
 	}
 	return d, cleanup, nil
 }
 
+// migrate 创建服务新增的表和字段, 已有的表结构不做改动
+func migrate(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&CommentContentRevision{}) {
+		if err := m.CreateTable(&CommentContentRevision{}); err != nil {
+			return err
+		}
+	}
+	if !m.HasColumn(&CommentContent{}, "EditedAt") {
+		if err := m.AddColumn(&CommentContent{}, "EditedAt"); err != nil {
+			return err
+		}
+	}
+	if !m.HasColumn(&CommentContent{}, "Mentions") {
+		if err := m.AddColumn(&CommentContent{}, "Mentions"); err != nil {
+			return err
+		}
+	}
+	if !m.HasColumn(&CommentIndex{}, "PinnedAt") {
+		if err := m.AddColumn(&CommentIndex{}, "PinnedAt"); err != nil {
+			return err
+		}
+	}
+	if !m.HasColumn(&CommentSubject{}, "StateChangedAt") {
+		if err := m.AddColumn(&CommentSubject{}, "StateChangedAt"); err != nil {
+			return err
+		}
+	}
+	for _, field := range []string{"Title", "Url", "Attributes"} {
+		if !m.HasColumn(&CommentSubject{}, field) {
+			if err := m.AddColumn(&CommentSubject{}, field); err != nil {
+				return err
+			}
+		}
+	}
+	// 表态取代旧的点赞记录, 建表时迁移已有的点赞
+	if !m.HasTable(&CommentReaction{}) {
+		if err := m.CreateTable(&CommentReaction{}); err != nil {
+			return err
+		}
+		if m.HasTable("comment_like") {
+			err := db.Exec("INSERT INTO comment_reaction (member_id, comment_id, reaction, created_at, updated_at) "+
+				"SELECT member_id, comment_id, ?, created_at, updated_at FROM comment_like WHERE deleted_at IS NULL",
+				biz.ReactionLike).Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if !m.HasTable(&CommentReactionCount{}) {
+		if err := m.CreateTable(&CommentReactionCount{}); err != nil {
+			return err
+		}
+		err := db.Exec("INSERT INTO comment_reaction_count (comment_id, reaction, count) "+
+			"SELECT id, ?, `like` FROM comment_index WHERE `like` > 0", biz.ReactionLike).Error
+		if err != nil {
+			return err
+		}
+	}
+	for _, table := range []interface{}{&Notification{}, &NotificationActor{}, &NotificationUnread{}, &CommentOutbox{}} {
+		if !m.HasTable(table) {
+			if err := m.CreateTable(table); err != nil {
+				return err
+			}
+		}
+	}
+	// actor_id 属于去重的唯一索引, 合并通知最近的触发人单独保存
+	if !m.HasColumn(&Notification{}, "LatestActorId") {
+		if err := m.AddColumn(&Notification{}, "LatestActorId"); err != nil {
+			return err
+		}
+	}
+	// 通知按来源去重, 建索引前删除重复投递产生的通知并重新统计未读数
+	if !m.HasIndex(&Notification{}, "idx_notification_source") {
+		err := db.Exec("DELETE n1 FROM comment_notification n1 JOIN comment_notification n2 " +
+			"ON n1.member_id = n2.member_id AND n1.type = n2.type AND n1.comment_id = n2.comment_id " +
+			"AND n1.actor_id = n2.actor_id AND n1.id > n2.id").Error
+		if err != nil {
+			return err
+		}
+		err = db.Exec("DELETE a FROM comment_notification_actor a LEFT JOIN comment_notification n " +
+			"ON a.notification_id = n.id WHERE n.id IS NULL").Error
+		if err != nil {
+			return err
+		}
+		err = db.Exec("UPDATE comment_notification_unread u SET count = (SELECT COUNT(*) FROM comment_notification n " +
+			"WHERE n.member_id = u.member_id AND n.read_at IS NULL)").Error
+		if err != nil {
+			return err
+		}
+		if err = m.CreateIndex(&Notification{}, "idx_notification_source"); err != nil {
+			return err
+		}
+	}
+	// 按成员查询评论历史使用, 主键 id 在 orm.Model 中无法通过标签声明联合索引
+	if !m.HasIndex(&CommentIndex{}, "idx_comment_index_member") {
+		err := db.Exec("CREATE INDEX idx_comment_index_member ON comment_index (member_id, id)").Error
+		if err != nil {
+			return err
+		}
+	}
+	// 按主题查询根评论与构建排序缓存使用
+	if !m.HasIndex(&CommentIndex{}, "idx_comment_index_obj") {
+		if err := m.CreateIndex(&CommentIndex{}, "idx_comment_index_obj"); err != nil {
+			return err
+		}
+	}
+	// job 保存评论时在主题锁内查询下一个楼层, 以及按主题校正计数使用
+	if !m.HasIndex(&CommentIndex{}, "idx_comment_index_subject") {
+		if err := m.CreateIndex(&CommentIndex{}, "idx_comment_index_subject"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
 func getOffset(page, size int) int {
 	offset := (page - 1) * size
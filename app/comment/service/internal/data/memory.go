@@ -0,0 +1,113 @@
+package data
+
+import (
+	"base-service/app/comment/service/internal/conf"
+	"base-service/pkg/memqueue"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"sync"
+)
+
+var _ Queue = (*Memory)(nil)
+
+// Memory 进程内的队列, 同一进程内的评论服务与 job 通过 memqueue.Default 共享消息
+// 每个订阅都会收到主题的所有消息, 按发送顺序逐条处理, 重试与死信的处理与 kafka 一致
+// 消息不会跨进程投递, 发送到本进程内没有订阅的主题时返回错误
+type Memory struct {
+	broker *memqueue.Broker
+	policy retryPolicy
+	subscriptions []*memqueue.Subscription
+	deadLetters []*memqueue.Subscription
+	closed bool
+	ctx context.Context
+	cancel context.CancelFunc
+	wg sync.WaitGroup
+	deadLetterWg sync.WaitGroup
+	mu sync.RWMutex
+	log log.Logger
+}
+
+func NewMemory(c *conf.Data_Kafka, logger log.Logger) *Memory {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Memory{
+		broker: memqueue.Default,
+		policy: newRetryPolicy(c),
+		ctx: ctx,
+		cancel: cancel,
+		log: logger,
+	}
+}
+
+func (m *Memory) Send(topic string, key string, value string, headers map[string]string) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return errQueueClosed
+	}
+	return m.broker.Publish(&memqueue.Message{
+		Topic: topic,
+		Key: key,
+		Headers: headers,
+		Value: []byte(value),
+	})
+}
+
+func (m *Memory) Subscribe(topic string, handler Handler) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return errQueueClosed
+	}
+	sub := m.broker.Subscribe(topic)
+	wg := &m.wg
+	if strings.HasSuffix(topic, DeadLetterSuffix) {
+		m.deadLetters = append(m.deadLetters, sub)
+		wg = &m.deadLetterWg
+	} else {
+		m.subscriptions = append(m.subscriptions, sub)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			msg, ok := sub.Next()
+			if !ok {
+				return
+			}
+			deliver(m.ctx, m, m.policy, handler, &Message{
+				Key: msg.Key,
+				Headers: msg.Headers,
+				Value: msg.Value,
+				Topic: msg.Topic,
+				Offset: msg.Offset,
+			}, m.log)
+		}
+	}()
+	return nil
+}
+
+// Start 订阅后立即开始消费, 不需要单独启动
+func (m *Memory) Start() error {
+	return nil
+}
+
+// close 停止订阅并处理完已收到的消息, 先关闭源主题, 处理完后再关闭死信主题, 处理期间产生的死信仍会保存
+func (m *Memory) close() {
+	m.mu.Lock()
+	subscriptions, deadLetters := m.subscriptions, m.deadLetters
+	m.subscriptions, m.deadLetters = nil, nil
+	m.mu.Unlock()
+	for _, sub := range subscriptions {
+		sub.Close()
+	}
+	m.wg.Wait()
+	for _, sub := range deadLetters {
+		sub.Close()
+	}
+	m.deadLetterWg.Wait()
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	m.cancel()
+}
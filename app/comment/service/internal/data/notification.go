@@ -0,0 +1,132 @@
+package data
+
+import (
+	"base-service/app/comment/service/internal/biz"
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+// Notification 成员收到的通知, 由 job 写入
+type Notification struct {
+	Id uint64 `gorm:"primarykey;autoIncrement:false"`
+	MemberId uint64 `gorm:"index:idx_notification_member,priority:1;uniqueIndex:idx_notification_source,priority:1"`
+	Type int8 `gorm:"uniqueIndex:idx_notification_source,priority:2"`
+	CommentId uint64 `gorm:"index;uniqueIndex:idx_notification_source,priority:3"`
+	ObjId uint64
+	ObjType int
+	ActorId uint64 `gorm:"uniqueIndex:idx_notification_source,priority:4"` // 首个触发人, 同一事件重复投递时只保存一条通知
+	LatestActorId uint64 // 合并通知最近的触发人, 为0时是 actor_id
+	ActorCount int `gorm:"default:1"`
+	ReadAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time `gorm:"index:idx_notification_member,priority:2"`
+}
+
+// NotificationActor 合并通知的触发人, 同一成员重复点赞不重复计数
+type NotificationActor struct {
+	NotificationId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ActorId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time
+}
+
+// NotificationUnread 成员未读的通知数
+type NotificationUnread struct {
+	MemberId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Count int
+}
+
+// latestActorId 最近的触发人, 合并前写入的通知没有 latest_actor_id
+func (n *Notification) latestActorId() uint64 {
+	if n.LatestActorId != 0 {
+		return n.LatestActorId
+	}
+	return n.ActorId
+}
+
+func (Notification) TableName() string {
+	return "comment_notification"
+}
+
+func (NotificationActor) TableName() string {
+	return "comment_notification_actor"
+}
+
+func (NotificationUnread) TableName() string {
+	return "comment_notification_unread"
+}
+
+type notificationRepo struct {
+	data *Data
+	log *log.Helper
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		data: data,
+		log: log.NewHelper(logger),
+	}
+}
+
+func (n notificationRepo) ListNotification(ctx context.Context, memberId uint64, page, size int) ([]*biz.Notification, error) {
+	var list []*Notification
+	result := n.data.db.WithContext(ctx).
+		Where("member_id = ?", memberId).
+		Order("updated_at desc, id desc").
+		Limit(size).
+		Offset(getOffset(page, size)).
+		Find(&list)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	notifications := make([]*biz.Notification, len(list))
+	for i, item := range list {
+		notifications[i] = &biz.Notification{
+			Id:         item.Id,
+			MemberId:   item.MemberId,
+			Type:       item.Type,
+			CommentId:  item.CommentId,
+			ObjId:      item.ObjId,
+			ObjType:    item.ObjType,
+			ActorId:    item.latestActorId(),
+			ActorCount: item.ActorCount,
+			Read:       item.ReadAt != nil,
+			CreatedAt:  item.CreatedAt,
+			UpdatedAt:  item.UpdatedAt,
+		}
+	}
+	return notifications, nil
+}
+
+// ReadNotification 标记已读并按实际标记的条数减少未读数
+func (n notificationRepo) ReadNotification(ctx context.Context, memberId uint64, ids []uint64) error {
+	return n.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&Notification{}).Where("member_id = ? AND read_at IS NULL", memberId)
+		if len(ids) > 0 {
+			query = query.Where("id IN ?", ids)
+		}
+		// 不修改 updated_at, 保持通知的排序
+		result := query.UpdateColumn("read_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if len(ids) == 0 {
+			return tx.Model(&NotificationUnread{}).
+				Where("member_id = ?", memberId).
+				Update("count", 0).Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return tx.Model(&NotificationUnread{}).
+			Where("member_id = ?", memberId).
+			Update("count", gorm.Expr("GREATEST(count - ?, 0)", result.RowsAffected)).Error
+	})
+}
+
+func (n notificationRepo) GetUnreadNotificationCount(ctx context.Context, memberId uint64) (int, error) {
+	var unread NotificationUnread
+	result := n.data.db.WithContext(ctx).Where("member_id = ?", memberId).Limit(1).Find(&unread)
+	return unread.Count, result.Error
+}
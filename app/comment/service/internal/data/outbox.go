@@ -0,0 +1,39 @@
+package data
+
+import (
+	jobV1 "base-service/api/comment/job/v1"
+	"base-service/pkg/orm"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"time"
+)
+
+// CommentEventTopic 评论领域事件的主题
+const CommentEventTopic = "comment-event"
+
+// CommentOutbox 待投递的评论事件, 与数据变更在同一事务中写入, 由 job 投递后删除
+type CommentOutbox struct {
+	Id uint64 `gorm:"primarykey;autoIncrement:false"`
+	Topic string `gorm:"size:128"`
+	Key string `gorm:"size:255"`
+	Payload []byte `gorm:"type:mediumblob"` // 事件内容, protobuf 编码
+	CreatedAt time.Time
+}
+
+func (CommentOutbox) TableName() string {
+	return "comment_outbox"
+}
+
+// saveCommentEvent 在事务中写入评论事件, key 为评论所属主题
+func saveCommentEvent(tx *gorm.DB, objId uint64, objType int, event *jobV1.CommentEvent) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&CommentOutbox{
+		Id: orm.NextId(),
+		Topic: CommentEventTopic,
+		Key: subjectKey(objId, objType),
+		Payload: payload,
+	}).Error
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"base-service/app/comment/service/internal/biz"
+	"base-service/pkg/orm"
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+// rateLimitScript 滑动窗口限流, 每个 key 是一个以毫秒时间戳为分数的有序集合
+// KEYS 为各规则的 key; ARGV[1] 为当前毫秒时间戳, ARGV[2] 为本次请求的唯一标识, 之后依次为每个 key 的次数与窗口毫秒数
+// 所有 key 都未超限时才计数, 返回0; 否则返回需要等待的毫秒数
+var rateLimitScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local wait = 0
+for i, key in ipairs(KEYS) do
+	local count = tonumber(ARGV[i * 2 + 1])
+	local window = tonumber(ARGV[i * 2 + 2])
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+	if redis.call('ZCARD', key) >= count then
+		local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+		local w = tonumber(oldest[2]) + window - now
+		if w < 1 then
+			w = 1
+		end
+		if w > wait then
+			wait = w
+		end
+	end
+end
+if wait > 0 then
+	return wait
+end
+for i, key in ipairs(KEYS) do
+	redis.call('ZADD', key, now, ARGV[2])
+	redis.call('PEXPIRE', key, ARGV[i * 2 + 2])
+end
+return 0
+`)
+
+func (c commentRepo) TakeRateLimit(ctx context.Context, limits []*biz.RateLimit) (time.Duration, error) {
+	keys := make([]string, len(limits))
+	args := []interface{}{time.Now().UnixNano() / int64(time.Millisecond), strconv.FormatUint(orm.NextId(), 10)}
+	for i, limit := range limits {
+		keys[i] = rateLimitKey(limit.Key)
+		args = append(args, limit.Count, limit.Window.Milliseconds())
+	}
+	wait, err := rateLimitScript.Run(ctx, c.data.redisDB, keys, args...).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+func rateLimitKey(key string) string {
+	return fmt.Sprintf("rl:%s", key)
+}
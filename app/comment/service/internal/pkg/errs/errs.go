@@ -0,0 +1,102 @@
+package errs
+
+import (
+	"fmt"
+	"time"
+)
+
+type Error struct {
+	Msg string
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("error: %s", e.Msg)
+}
+
+// RateLimitError 发表评论过于频繁, RetryAfter 为建议的重试等待时间
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e RateLimitError) Error() string {
+	return fmt.Sprintf("error: Rate Limited, retry after %s", e.RetryAfter)
+}
+
+var (
+	ErrNotFound = Error{Msg: "Record Not Found"}
+	ErrPermissionDenied = Error{Msg: "Permission Denied"}
+	ErrInvalidState = Error{Msg: "Invalid State"}
+	ErrEditWindowExpired = Error{Msg: "Edit Window Expired"}
+	ErrInvalidCursor = Error{Msg: "Invalid Cursor"}
+	ErrPinLimitExceeded = Error{Msg: "Pin Limit Exceeded"}
+	ErrInvalidReaction = Error{Msg: "Invalid Reaction"}
+	ErrSubjectClosed = Error{Msg: "Subject Closed"}
+	ErrInvalidIdempotencyKey = Error{Msg: "Invalid Idempotency Key"}
+	ErrInvalidSubject = Error{Msg: "Invalid Subject"}
+	ErrMessageTooLong = Error{Msg: "Message Too Long"}
+	ErrReplyNotAllowed = Error{Msg: "Reply Not Allowed"}
+	ErrIdempotencyKeyReused = Error{Msg: "Idempotency Key Reused"}
+	ErrRequestInProgress = Error{Msg: "Request In Progress"}
+)
+
+
+func IsNotFound(err error) bool {
+	return err == ErrNotFound
+}
+
+func IsPermissionDenied(err error) bool {
+	return err == ErrPermissionDenied
+}
+
+func IsInvalidState(err error) bool {
+	return err == ErrInvalidState
+}
+
+func IsEditWindowExpired(err error) bool {
+	return err == ErrEditWindowExpired
+}
+
+func IsInvalidCursor(err error) bool {
+	return err == ErrInvalidCursor
+}
+
+func IsPinLimitExceeded(err error) bool {
+	return err == ErrPinLimitExceeded
+}
+
+func IsInvalidReaction(err error) bool {
+	return err == ErrInvalidReaction
+}
+
+func IsSubjectClosed(err error) bool {
+	return err == ErrSubjectClosed
+}
+
+func IsInvalidIdempotencyKey(err error) bool {
+	return err == ErrInvalidIdempotencyKey
+}
+
+func IsInvalidSubject(err error) bool {
+	return err == ErrInvalidSubject
+}
+
+func IsMessageTooLong(err error) bool {
+	return err == ErrMessageTooLong
+}
+
+func IsReplyNotAllowed(err error) bool {
+	return err == ErrReplyNotAllowed
+}
+
+func IsRateLimited(err error) bool {
+	_, ok := err.(RateLimitError)
+	return ok
+}
+
+func IsIdempotencyKeyReused(err error) bool {
+	return err == ErrIdempotencyKeyReused
+}
+
+func IsRequestInProgress(err error) bool {
+	return err == ErrRequestInProgress
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"base-service/app/comment/service/internal/biz"
+	"base-service/app/comment/service/internal/pkg/errs"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"strconv"
 
 	pb "base-service/api/comment/service/v1"
 )
@@ -11,12 +14,14 @@ import (
 type CommentService struct {
 	pb.UnimplementedCommentServer
 	uc *biz.CommentUsecase
+	nuc *biz.NotificationUsecase
 	log *log.Helper
 }
 
-func NewCommentService(uc *biz.CommentUsecase, logger log.Logger) *CommentService {
+func NewCommentService(uc *biz.CommentUsecase, nuc *biz.NotificationUsecase, logger log.Logger) *CommentService {
 	return &CommentService{
 		uc: uc,
+		nuc: nuc,
 		log: log.NewHelper(logger),
 	}
 }
@@ -38,39 +43,155 @@ func (s *CommentService) CreateComment(ctx context.Context, req *pb.CreateCommen
 		Device:      req.Device,
 		Message:     req.Message,
 		Meta:        req.Meta,
+		Mentions:    toBizMentions(req.Mentions),
+		IdempotencyKey: req.IdempotencyKey,
 	}
 	err := s.uc.CreateComment(ctx, subject, comment)
-	return &pb.CreateCommentReply{Id: comment.Id}, err
+	if err != nil {
+		if e, ok := err.(errs.RateLimitError); ok {
+			// retry_after 为建议的重试等待秒数
+			retryAfter := int64(math.Ceil(e.RetryAfter.Seconds()))
+			return nil, pb.ErrorCommentServiceErrorReasonRateLimited("member %d comments too frequently", req.MemberId).
+				WithMetadata(map[string]string{"retry_after": strconv.FormatInt(retryAfter, 10)})
+		}
+		if errs.IsSubjectClosed(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonSubjectClosed("subject %d-%d is closed", req.ObjId, req.ObjType)
+		}
+		if errs.IsInvalidIdempotencyKey(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidIdempotencyKey("idempotency key is longer than 64")
+		}
+		if errs.IsIdempotencyKeyReused(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonIdempotencyKeyReused("idempotency key %s is used by another request", req.IdempotencyKey)
+		}
+		if errs.IsRequestInProgress(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonRequestInProgress("request %s is in progress", req.IdempotencyKey)
+		}
+		if errs.IsMessageTooLong(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonMessageTooLong("message is too long for obj_type %d", req.ObjType)
+		}
+		if errs.IsReplyNotAllowed(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonReplyNotAllowed("reply depth is not allowed for obj_type %d", req.ObjType)
+		}
+		return nil, err
+	}
+	return &pb.CreateCommentReply{Id: comment.Id}, nil
 }
 
 func (s *CommentService) GetComment(ctx context.Context, req *pb.GetCommentRequest) (*pb.GetCommentReply, error) {
-	result, err := s.uc.GetCommentById(ctx, req.Id)
+	result, err := s.uc.GetCommentById(ctx, req.Id, req.MemberId)
 	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonCommentNotFound("comment %d not found", req.Id)
+		}
 		return nil, err
 	}
 	return &pb.GetCommentReply{Comment: createCommentData(result)}, nil
 }
 
-func (s *CommentService) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentReply, error) {
-	err := s.uc.LikeComment(ctx, req.Id, int(req.Like), req.MemberId)
-	return &pb.LikeCommentReply{}, err
+func (s *CommentService) GetCommentStatus(ctx context.Context, req *pb.GetCommentStatusRequest) (*pb.GetCommentStatusReply, error) {
+	status, comment, err := s.uc.GetCommentStatus(ctx, req.Id, req.MemberId)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonCommentNotFound("comment %d not found", req.Id)
+		}
+		return nil, err
+	}
+	reply := &pb.GetCommentStatusReply{Status: pb.CommentStatus(status)}
+	if status != biz.CommentStatusQueued {
+		reply.State = int32(comment.State)
+	}
+	return reply, nil
 }
 
-func (s *CommentService) DeleteComment(context.Context, *pb.DeleteCommentRequest) (*pb.DeleteCommentReply, error) {
+func (s *CommentService) ReactComment(ctx context.Context, req *pb.ReactCommentRequest) (*pb.ReactCommentReply, error) {
+	err := s.uc.ReactComment(ctx, req.Id, req.MemberId, req.Reaction)
+	if err != nil {
+		switch {
+		case errs.IsNotFound(err):
+			return nil, pb.ErrorCommentServiceErrorReasonCommentNotFound("comment %d not found", req.Id)
+		case errs.IsInvalidReaction(err):
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidReaction("unsupported reaction %s", req.Reaction)
+		case errs.IsInvalidState(err):
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidState("comment %d can not be reacted", req.Id)
+		case errs.IsSubjectClosed(err):
+			return nil, pb.ErrorCommentServiceErrorReasonSubjectClosed("subject of comment %d is locked", req.Id)
+		}
+		return nil, err
+	}
+	return &pb.ReactCommentReply{}, nil
+}
+
+func (s *CommentService) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.UpdateCommentReply, error) {
+	err := s.uc.UpdateComment(ctx, &biz.Comment{
+		Id:       req.Id,
+		MemberId: req.MemberId,
+		Message:  req.Message,
+		Meta:     req.Meta,
+		Mentions: toBizMentions(req.Mentions),
+	})
+	if err != nil {
+		switch {
+		case errs.IsNotFound(err):
+			return nil, pb.ErrorCommentServiceErrorReasonCommentNotFound("comment %d not found", req.Id)
+		case errs.IsPermissionDenied(err):
+			return nil, pb.ErrorCommentServiceErrorReasonPermissionDenied("member %d can not edit comment %d", req.MemberId, req.Id)
+		case errs.IsInvalidState(err):
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidState("comment %d can not be edited", req.Id)
+		case errs.IsEditWindowExpired(err):
+			return nil, pb.ErrorCommentServiceErrorReasonEditWindowExpired("comment %d is out of edit window", req.Id)
+		case errs.IsSubjectClosed(err):
+			return nil, pb.ErrorCommentServiceErrorReasonSubjectClosed("subject of comment %d is locked", req.Id)
+		case errs.IsMessageTooLong(err):
+			return nil, pb.ErrorCommentServiceErrorReasonMessageTooLong("message of comment %d is too long", req.Id)
+		}
+		return nil, err
+	}
+	return &pb.UpdateCommentReply{}, nil
+}
+
+func (s *CommentService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentReply, error) {
+	err := s.uc.DeleteComment(ctx, req.Id, req.MemberId, req.Admin)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonCommentNotFound("comment %d not found", req.Id)
+		}
+		if errs.IsPermissionDenied(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonPermissionDenied("member %d can not delete comment %d", req.MemberId, req.Id)
+		}
+		if errs.IsSubjectClosed(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonSubjectClosed("subject of comment %d is locked", req.Id)
+		}
+		return nil, err
+	}
 	return &pb.DeleteCommentReply{}, nil
 }
 
 func (s *CommentService) ListComment(ctx context.Context, req *pb.ListCommentRequest) (*pb.ListCommentReply, error) {
-	comments, err := s.uc.GetComments(ctx, &biz.CommentSubject{
+	cursor, err := biz.ParseCommentCursor(req.Cursor)
+	if err != nil {
+		return nil, pb.ErrorCommentServiceErrorReasonInvalidCursor("invalid cursor %s", req.Cursor)
+	}
+	// 未指定时使用 obj_type 的默认值
+	sort, replyCount := -1, -1
+	if req.Sort != nil {
+		sort = int(req.GetSort())
+	}
+	if req.ReplyCount != nil {
+		replyCount = int(req.GetReplyCount())
+	}
+	page, err := s.uc.GetComments(ctx, &biz.CommentSubject{
 		ObjType: int(req.ObjType),
 		ObjId: req.ObjId,
-	}, int(req.Page), int(req.Size), int(req.ReplyCount))
+	}, sort, cursor, int(req.Size), replyCount, req.MemberId)
 	if err != nil {
+		if errs.IsInvalidCursor(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidCursor("cursor %s is expired", req.Cursor)
+		}
 		s.log.Errorf("get comments failed: %v", err)
 		return nil, err
 	}
-	result := make([]*pb.CommentData, len(comments))
-	for i, v := range comments {
+	result := make([]*pb.CommentData, len(page.Comments))
+	for i, v := range page.Comments {
 		result[i] = createCommentData(v)
 		// 迭代创建子评论
 		if len(v.Replies) > 0 {
@@ -81,19 +202,55 @@ func (s *CommentService) ListComment(ctx context.Context, req *pb.ListCommentReq
 	}
 	return &pb.ListCommentReply{
 		Comments: result,
+		NextCursor: page.NextCursor.String(),
+		HasMore: page.HasMore,
 	}, nil
 }
 
 func (s *CommentService) ListSubComment(ctx context.Context, req *pb.ListSubCommentRequest) (*pb.ListCommentReply, error) {
-	comments, err := s.uc.GetReplies(ctx, req.RootId, int(req.Page), int(req.Size))
+	cursor, err := biz.ParseCommentCursor(req.Cursor)
 	if err != nil {
+		return nil, pb.ErrorCommentServiceErrorReasonInvalidCursor("invalid cursor %s", req.Cursor)
+	}
+	page, err := s.uc.GetReplies(ctx, req.RootId, cursor, int(req.Size))
+	if err != nil {
+		if errs.IsInvalidCursor(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidCursor("invalid cursor %s", req.Cursor)
+		}
 		return nil, err
 	}
-	result := make([]*pb.CommentData, len(comments))
-	for i := range comments {
-		result[i] = createCommentData(comments[i])
+	result := make([]*pb.CommentData, len(page.Comments))
+	for i := range page.Comments {
+		result[i] = createCommentData(page.Comments[i])
 	}
-	return &pb.ListCommentReply{Comments: result}, nil
+	return &pb.ListCommentReply{
+		Comments: result,
+		NextCursor: page.NextCursor.String(),
+		HasMore: page.HasMore,
+	}, nil
+}
+
+func (s *CommentService) ListCommentsByMember(ctx context.Context, req *pb.ListCommentsByMemberRequest) (*pb.ListCommentReply, error) {
+	cursor, err := biz.ParseCommentCursor(req.Cursor)
+	if err != nil {
+		return nil, pb.ErrorCommentServiceErrorReasonInvalidCursor("invalid cursor %s", req.Cursor)
+	}
+	page, err := s.uc.ListCommentsByMember(ctx, req.MemberId, cursor, int(req.Size))
+	if err != nil {
+		if errs.IsInvalidCursor(err) {
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidCursor("invalid cursor %s", req.Cursor)
+		}
+		return nil, err
+	}
+	result := make([]*pb.CommentData, len(page.Comments))
+	for i := range page.Comments {
+		result[i] = createCommentData(page.Comments[i])
+	}
+	return &pb.ListCommentReply{
+		Comments: result,
+		NextCursor: page.NextCursor.String(),
+		HasMore: page.HasMore,
+	}, nil
 }
 
 func (s *CommentService) GetCommentSubject(ctx context.Context, req *pb.GetCommentSubjectRequest) (*pb.GetCommentSubjectReply, error) {
@@ -102,8 +259,16 @@ func (s *CommentService) GetCommentSubject(ctx context.Context, req *pb.GetComme
 		ObjId:   req.ObjId,
 	}
 	err := s.uc.GetSubject(ctx, subject)
+	if errs.IsNotFound(err) {
+		// 未登记且没有评论的主题, 返回开放状态的空主题
+		return &pb.GetCommentSubjectReply{
+			ObjId:   req.ObjId,
+			ObjType: req.ObjType,
+			State:   int32(biz.SubjectStateOpen),
+		}, nil
+	}
 	if err != nil {
-		s.log.Errorf("get comment subject failed, subjectId: %d, subjectType: %s\nerr: %v", req.ObjId, req.ObjType, err)
+		s.log.Errorf("get comment subject failed, subjectId: %d, subjectType: %d\nerr: %v", req.ObjId, req.ObjType, err)
 		return nil, err
 	}
 	return &pb.GetCommentSubjectReply{
@@ -116,6 +281,66 @@ func (s *CommentService) GetCommentSubject(ctx context.Context, req *pb.GetComme
 		AllCount:  int32(subject.AllCount),
 		State: 	   int32(subject.State),
 		CreatedAt: subject.CreatedAt.Unix(),
+		Title:      subject.Title,
+		Url:        subject.Url,
+		Attributes: subject.Attributes,
+	}, nil
+}
+
+func (s *CommentService) RegisterSubject(ctx context.Context, req *pb.RegisterSubjectRequest) (*pb.RegisterSubjectReply, error) {
+	subject := &biz.CommentSubject{
+		ObjType:    int(req.ObjType),
+		ObjId:      req.ObjId,
+		MemberId:   req.MemberId,
+		Title:      req.Title,
+		Url:        req.Url,
+		Attributes: req.Attributes,
+	}
+	err := s.uc.RegisterSubject(ctx, subject)
+	if err != nil {
+		switch {
+		case errs.IsPermissionDenied(err):
+			return nil, pb.ErrorCommentServiceErrorReasonPermissionDenied("subject %d-%d is owned by member %d", req.ObjId, req.ObjType, subject.MemberId)
+		case errs.IsInvalidSubject(err):
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidSubject("title, url or attributes of subject %d-%d too long", req.ObjId, req.ObjType)
+		}
+		return nil, err
+	}
+	return &pb.RegisterSubjectReply{Id: subject.Id}, nil
+}
+
+func (s *CommentService) UpdateSubject(ctx context.Context, req *pb.UpdateSubjectRequest) (*pb.UpdateSubjectReply, error) {
+	subject := &biz.CommentSubject{
+		ObjType:    int(req.ObjType),
+		ObjId:      req.ObjId,
+		Title:      req.Title,
+		Url:        req.Url,
+		Attributes: req.Attributes,
+	}
+	err := s.uc.UpdateSubject(ctx, subject, req.MemberId, req.Admin, req.OwnerId)
+	if err != nil {
+		switch {
+		case errs.IsNotFound(err):
+			return nil, pb.ErrorCommentServiceErrorReasonSubjectNotFound("subject %d-%d not found", req.ObjId, req.ObjType)
+		case errs.IsPermissionDenied(err):
+			return nil, pb.ErrorCommentServiceErrorReasonPermissionDenied("member %d can not update subject %d-%d", req.MemberId, req.ObjId, req.ObjType)
+		case errs.IsInvalidSubject(err):
+			return nil, pb.ErrorCommentServiceErrorReasonInvalidSubject("title, url or attributes of subject %d-%d too long", req.ObjId, req.ObjType)
+		}
+		return nil, err
+	}
+	return &pb.UpdateSubjectReply{}, nil
+}
+
+func (s *CommentService) GetCommentPolicy(ctx context.Context, req *pb.GetCommentPolicyRequest) (*pb.GetCommentPolicyReply, error) {
+	policy := s.uc.GetPolicy(int(req.ObjType))
+	return &pb.GetCommentPolicyReply{
+		MaxMessageLength:  int32(policy.MaxMessageLength),
+		MaxReplyDepth:     int32(policy.MaxReplyDepth),
+		DefaultSort:       int32(policy.DefaultSort),
+		Moderation:        policy.Moderation,
+		AnonymousRead:     policy.AnonymousRead,
+		ReplyPreviewCount: int32(policy.ReplyPreviewCount),
 	}, nil
 }
 
@@ -136,29 +361,124 @@ func (s *CommentService) ListCommentSubject(ctx context.Context, req *pb.ListCom
 			AllCount:  int32(item.AllCount),
 			State: 	   int32(item.State),
 			CreatedAt: item.CreatedAt.Unix(),
+			Title:      item.Title,
+			Url:        item.Url,
+			Attributes: item.Attributes,
 		}
 		result[i] = cs
 	}
 	return &pb.ListCommentSubjectReply{CommentSubjects: result}, nil
 }
 
-func (s *CommentService) GetCommentLiked(ctx context.Context, req *pb.GetCommentLikedRequest) (*pb.GetCommentLikedReply, error) {
-	result, err := s.uc.GetLikedComment(ctx, req.MemberId, req.CommentId)
+func (s *CommentService) ListMemberReaction(ctx context.Context, req *pb.ListMemberReactionRequest) (*pb.ListMemberReactionReply, error) {
+	reactions, err := s.uc.ListMemberReaction(ctx, req.MemberId, req.CommentIds)
 	if err != nil {
 		return nil, err
 	}
-	items := make([]*pb.GetCommentLikedReply_LikedItem, len(result))
-	for i := range result {
-		items[i] = &pb.GetCommentLikedReply_LikedItem{
-			CommentId: result[i].CommentId,
-			Like:      result[i].Like,
-		}
+	return &pb.ListMemberReactionReply{Reactions: reactions}, nil
+}
+func (s *CommentService) ListPendingComment(ctx context.Context, req *pb.ListPendingCommentRequest) (*pb.ListCommentReply, error) {
+	comments, err := s.uc.ListPendingComment(ctx, int(req.ObjType), int(req.Page), int(req.Size))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.CommentData, len(comments))
+	for i := range comments {
+		result[i] = createCommentData(comments[i])
+	}
+	return &pb.ListCommentReply{Comments: result}, nil
+}
+
+func (s *CommentService) ReviewComment(ctx context.Context, req *pb.ReviewCommentRequest) (*pb.ReviewCommentReply, error) {
+	if _, ok := pb.CommentState_name[req.State]; !ok {
+		return nil, pb.ErrorCommentServiceErrorReasonInvalidState("invalid comment state %d", req.State)
+	}
+	succeeded, failed := s.uc.ReviewComment(ctx, req.Ids, int8(req.State))
+	return &pb.ReviewCommentReply{
+		SucceededIds: succeeded,
+		FailedIds:    failed,
+	}, nil
+}
+
+func (s *CommentService) PinComment(ctx context.Context, req *pb.PinCommentRequest) (*pb.PinCommentReply, error) {
+	err := s.uc.PinComment(ctx, req.Id, req.MemberId, req.Admin, true)
+	if err != nil {
+		return nil, pinCommentError(err, req.Id, req.MemberId)
+	}
+	return &pb.PinCommentReply{}, nil
+}
+
+func (s *CommentService) UnpinComment(ctx context.Context, req *pb.UnpinCommentRequest) (*pb.UnpinCommentReply, error) {
+	err := s.uc.PinComment(ctx, req.Id, req.MemberId, req.Admin, false)
+	if err != nil {
+		return nil, pinCommentError(err, req.Id, req.MemberId)
 	}
-	return &pb.GetCommentLikedReply{LikedItems: items}, nil
+	return &pb.UnpinCommentReply{}, nil
+}
+
+func pinCommentError(err error, id uint64, memberId uint64) error {
+	switch {
+	case errs.IsNotFound(err):
+		return pb.ErrorCommentServiceErrorReasonCommentNotFound("comment %d not found", id)
+	case errs.IsPermissionDenied(err):
+		return pb.ErrorCommentServiceErrorReasonPermissionDenied("member %d is not the subject owner of comment %d", memberId, id)
+	case errs.IsInvalidState(err):
+		return pb.ErrorCommentServiceErrorReasonInvalidState("comment %d can not be pinned", id)
+	case errs.IsPinLimitExceeded(err):
+		return pb.ErrorCommentServiceErrorReasonPinLimitExceeded("too many pinned comments")
+	case errs.IsSubjectClosed(err):
+		return pb.ErrorCommentServiceErrorReasonSubjectClosed("subject of comment %d is locked", id)
+	}
+	return err
+}
+
+func (s *CommentService) OpenSubject(ctx context.Context, req *pb.OpenSubjectRequest) (*pb.OpenSubjectReply, error) {
+	err := s.updateSubjectState(ctx, req.ObjId, req.ObjType, req.MemberId, req.Admin, biz.SubjectStateOpen)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OpenSubjectReply{}, nil
+}
+
+func (s *CommentService) CloseSubject(ctx context.Context, req *pb.CloseSubjectRequest) (*pb.CloseSubjectReply, error) {
+	err := s.updateSubjectState(ctx, req.ObjId, req.ObjType, req.MemberId, req.Admin, biz.SubjectStateClosed)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CloseSubjectReply{}, nil
+}
+
+func (s *CommentService) LockSubject(ctx context.Context, req *pb.LockSubjectRequest) (*pb.LockSubjectReply, error) {
+	err := s.updateSubjectState(ctx, req.ObjId, req.ObjType, 0, true, biz.SubjectStateLocked)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LockSubjectReply{}, nil
+}
+
+func (s *CommentService) updateSubjectState(ctx context.Context, objId uint64, objType int32, memberId uint64, admin bool, state int8) error {
+	subject := &biz.CommentSubject{
+		ObjType: int(objType),
+		ObjId:   objId,
+	}
+	err := s.uc.UpdateSubjectState(ctx, subject, memberId, admin, state)
+	return updateSubjectStateError(err, objId, objType, memberId, state)
+}
+
+func updateSubjectStateError(err error, objId uint64, objType int32, memberId uint64, state int8) error {
+	switch {
+	case errs.IsPermissionDenied(err):
+		return pb.ErrorCommentServiceErrorReasonPermissionDenied("member %d can not change state of subject %d-%d", memberId, objId, objType)
+	case errs.IsInvalidState(err):
+		return pb.ErrorCommentServiceErrorReasonInvalidState("invalid subject state %d", state)
+	case errs.IsNotFound(err):
+		return pb.ErrorCommentServiceErrorReasonSubjectNotFound("subject %d-%d not found", objId, objType)
+	}
+	return err
 }
 
 func createCommentData(comment *biz.Comment) *pb.CommentData {
-	return &pb.CommentData{
+	data := &pb.CommentData{
 		Id:          comment.Id,
 		MemberId:    comment.MemberId,
 		Root:        comment.Root,
@@ -179,5 +499,39 @@ func createCommentData(comment *biz.Comment) *pb.CommentData {
 		CreateAt:    comment.CreatedAt.Unix(),
 		UpdatedAt:   comment.UpdatedAt.Unix(),
 		Replies:     make([]*pb.CommentData, 0),
+		Edited:      comment.Edited,
+		Pinned:      comment.Pinned,
+		Reactions:   make(map[string]int32, len(comment.Reactions)),
+		FilterWords: comment.FilterWords,
+		Mentions:    make([]*pb.Mention, len(comment.Mentions)),
+		ObjId:       comment.ObjId,
+		ObjType:     int32(comment.ObjType),
+		Queued:      comment.Queued,
 	}
-}
\ No newline at end of file
+	for i, mention := range comment.Mentions {
+		data.Mentions[i] = &pb.Mention{
+			MemberId: mention.MemberId,
+			Start:    int32(mention.Start),
+			End:      int32(mention.End),
+		}
+	}
+	for reaction, count := range comment.Reactions {
+		data.Reactions[reaction] = int32(count)
+	}
+	if comment.Edited {
+		data.EditedAt = comment.EditedAt.Unix()
+	}
+	return data
+}
+
+func toBizMentions(mentions []*pb.Mention) []biz.Mention {
+	ret := make([]biz.Mention, len(mentions))
+	for i, mention := range mentions {
+		ret[i] = biz.Mention{
+			MemberId: mention.MemberId,
+			Start:    int(mention.Start),
+			End:      int(mention.End),
+		}
+	}
+	return ret
+}
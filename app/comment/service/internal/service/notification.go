@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	pb "base-service/api/comment/service/v1"
+)
+
+func (s *CommentService) ListNotification(ctx context.Context, req *pb.ListNotificationRequest) (*pb.ListNotificationReply, error) {
+	notifications, err := s.nuc.ListNotification(ctx, req.MemberId, int(req.Page), int(req.Size))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.NotificationData, len(notifications))
+	for i, item := range notifications {
+		result[i] = &pb.NotificationData{
+			Id:         item.Id,
+			MemberId:   item.MemberId,
+			Type:       int32(item.Type),
+			CommentId:  item.CommentId,
+			ObjId:      item.ObjId,
+			ObjType:    int32(item.ObjType),
+			ActorId:    item.ActorId,
+			ActorCount: int32(item.ActorCount),
+			Read:       item.Read,
+			CreatedAt:  item.CreatedAt.Unix(),
+			UpdatedAt:  item.UpdatedAt.Unix(),
+		}
+	}
+	return &pb.ListNotificationReply{Notifications: result}, nil
+}
+
+func (s *CommentService) ReadNotification(ctx context.Context, req *pb.ReadNotificationRequest) (*pb.ReadNotificationReply, error) {
+	if err := s.nuc.ReadNotification(ctx, req.MemberId, req.Ids); err != nil {
+		return nil, err
+	}
+	return &pb.ReadNotificationReply{}, nil
+}
+
+func (s *CommentService) GetUnreadNotificationCount(ctx context.Context, req *pb.GetUnreadNotificationCountRequest) (*pb.GetUnreadNotificationCountReply, error) {
+	count, err := s.nuc.GetUnreadNotificationCount(ctx, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUnreadNotificationCountReply{Count: int32(count)}, nil
+}
@@ -0,0 +1,134 @@
+// Package memqueue 进程内的消息队列, 用于本地开发与集成测试
+// 同一进程内的服务共享 Default, 评论服务发送的消息可以直接由同进程的 job 消费
+// 不同进程之间不共享消息, 发送到没有订阅的主题会返回 ErrNoSubscriber, 而不是静默丢弃
+package memqueue
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNoSubscriber 主题在本进程内没有订阅, 消息无法投递
+var ErrNoSubscriber = errors.New("memqueue: no subscriber")
+
+// Default 进程内共享的队列
+var Default = NewBroker()
+
+type Message struct {
+	Topic string
+	Key string
+	Headers map[string]string
+	Value []byte
+	Offset int64 // 消息在主题内的序号, 从 0 开始
+}
+
+// Broker 按主题广播消息, 每个订阅都会收到订阅之后发送到该主题的所有消息
+type Broker struct {
+	mu sync.Mutex
+	offsets map[string]int64
+	subscriptions map[string][]*Subscription
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		offsets: make(map[string]int64),
+		subscriptions: make(map[string][]*Subscription),
+	}
+}
+
+// Publish 发送消息, 不会阻塞, 主题没有订阅时返回 ErrNoSubscriber
+func (b *Broker) Publish(msg *Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.subscriptions[msg.Topic]) == 0 {
+		return fmt.Errorf("publish to %s: %w", msg.Topic, ErrNoSubscriber)
+	}
+	msg.Offset = b.offsets[msg.Topic]
+	b.offsets[msg.Topic]++
+	for _, sub := range b.subscriptions[msg.Topic] {
+		sub.push(msg)
+	}
+	return nil
+}
+
+// Subscribe 订阅主题, 消息按发送顺序通过 Next 取出
+func (b *Broker) Subscribe(topic string) *Subscription {
+	sub := &Subscription{
+		broker: b,
+		topic: topic,
+		notify: make(chan struct{}, 1),
+	}
+	b.mu.Lock()
+	b.subscriptions[topic] = append(b.subscriptions[topic], sub)
+	b.mu.Unlock()
+	return sub
+}
+
+func (b *Broker) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subs := b.subscriptions[sub.topic]
+	for i := range subs {
+		if subs[i] == sub {
+			b.subscriptions[sub.topic] = append(subs[:i:i], subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Subscription 一个订阅, 未取出的消息缓存在内存中
+type Subscription struct {
+	broker *Broker
+	topic string
+	mu sync.Mutex
+	pending []*Message
+	closed bool
+	notify chan struct{}
+}
+
+func (s *Subscription) push(msg *Message) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.pending = append(s.pending, msg)
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Next 取出下一条消息, 没有消息时等待; 订阅关闭且已取完缓存的消息时返回 false
+func (s *Subscription) Next() (*Message, bool) {
+	for {
+		s.mu.Lock()
+		if len(s.pending) > 0 {
+			msg := s.pending[0]
+			s.pending[0] = nil
+			s.pending = s.pending[1:]
+			s.mu.Unlock()
+			return msg, true
+		}
+		if s.closed {
+			s.mu.Unlock()
+			return nil, false
+		}
+		s.mu.Unlock()
+		<-s.notify
+	}
+}
+
+// Close 不再接收新的消息, 已缓存的消息仍可以通过 Next 取出
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
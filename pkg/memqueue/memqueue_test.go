@@ -0,0 +1,114 @@
+package memqueue
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPublishFanOut(t *testing.T) {
+	b := NewBroker()
+	a1, a2 := b.Subscribe("a"), b.Subscribe("a")
+	other := b.Subscribe("b")
+	for i := 0; i < 3; i++ {
+		if err := b.Publish(&Message{Topic: "a", Key: "k"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, sub := range []*Subscription{a1, a2} {
+		for want := int64(0); want < 3; want++ {
+			msg, ok := sub.Next()
+			if !ok || msg.Offset != want {
+				t.Fatalf("Next() = %v, %v, want offset %d", msg, ok, want)
+			}
+		}
+	}
+	other.Close()
+	if msg, ok := other.Next(); ok {
+		t.Errorf("subscription of another topic received %v", msg)
+	}
+}
+
+func TestPublishWithoutSubscriber(t *testing.T) {
+	b := NewBroker()
+	tests := []struct {
+		name string
+		setup func()
+		wantErr bool
+	}{
+		{"never subscribed", func() {}, true},
+		{"subscribed", func() { b.Subscribe("a") }, false},
+		{"subscription closed", func() {
+			b.subscriptions = make(map[string][]*Subscription)
+			b.Subscribe("a").Close()
+		}, true},
+	}
+	for _, tt := range tests {
+		tt.setup()
+		err := b.Publish(&Message{Topic: "a"})
+		if got := errors.Is(err, ErrNoSubscriber); got != tt.wantErr {
+			t.Errorf("%s: err = %v, want ErrNoSubscriber %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCloseDrainsPending(t *testing.T) {
+	b := NewBroker()
+	sub := b.Subscribe("a")
+	_ = b.Publish(&Message{Topic: "a", Key: "1"})
+	_ = b.Publish(&Message{Topic: "a", Key: "2"})
+	sub.Close()
+	// 关闭后不再接收新消息, 已缓存的消息仍按顺序取出
+	if err := b.Publish(&Message{Topic: "a", Key: "3"}); !errors.Is(err, ErrNoSubscriber) {
+		t.Errorf("publish after close err = %v", err)
+	}
+	for _, want := range []string{"1", "2"} {
+		msg, ok := sub.Next()
+		if !ok || msg.Key != want {
+			t.Fatalf("Next() = %v, %v, want key %s", msg, ok, want)
+		}
+	}
+	if _, ok := sub.Next(); ok {
+		t.Errorf("Next() after drained should return false")
+	}
+}
+
+func TestNextWaitsForPublish(t *testing.T) {
+	b := NewBroker()
+	sub := b.Subscribe("a")
+	done := make(chan *Message)
+	go func() {
+		msg, _ := sub.Next()
+		done <- msg
+	}()
+	time.Sleep(10 * time.Millisecond)
+	_ = b.Publish(&Message{Topic: "a", Key: "k"})
+	select {
+	case msg := <-done:
+		if msg == nil || msg.Key != "k" {
+			t.Errorf("Next() = %v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Next() did not return after publish")
+	}
+}
+
+func TestCloseWakesWaitingNext(t *testing.T) {
+	b := NewBroker()
+	sub := b.Subscribe("a")
+	done := make(chan bool)
+	go func() {
+		_, ok := sub.Next()
+		done <- ok
+	}()
+	time.Sleep(10 * time.Millisecond)
+	sub.Close()
+	select {
+	case ok := <-done:
+		if ok {
+			t.Errorf("Next() after close = true")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Next() did not return after close")
+	}
+}